@@ -26,44 +26,115 @@ package bricker
 import (
 	"github.com/dirkjabl/bricker/connector"
 	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
 	"github.com/dirkjabl/bricker/util/hash"
+	"sync"
+	"time"
 )
 
 // The bricker type.
 // A bricker managed connectors and subscriber.
+// All events from the connectors are dispatched by one internal loop,
+// the state of the bricker is protected by a lock, so a bricker could be used from many go routines.
+// The events of a subscriber are delivered in the order of the dispatching,
+// a subscriber gets the next event after his notify of the last event returns.
 type Bricker struct {
+	lock              *sync.RWMutex
+	stop              *sync.Once
 	connection        map[string]connector.Connector
+	sequences         map[string]*connector.Sequence
 	first             string
 	uids              map[uint32]string
 	subscriber        map[hash.Hash]map[string]Subscriber
 	choosers          []uint8
+	requests          map[request][]Subscriber
+	waiting           map[subscriberKey]request
+	replays           map[subscriberKey]replay
+	settings          map[setting]*packet.Packet
+	statesubscriber   map[string]Subscriber
 	defaultsubscriber Subscriber
+	mlock             *sync.Mutex
+	mailboxes         map[Subscriber][]*event.Event
+	timeout           time.Duration
+	events            chan *event.Event
+	quit              chan struct{}
 }
 
 // New create the bricker.
-// The new bricker start direct the service.
+// The new bricker start direct the service (the dispatch loop).
 // After start, the bricker has no connection and no subscriber.
+// The timeout for synchronized calls is the DefaultTimeout.
 func New() *Bricker {
-	return &Bricker{
-		connection: make(map[string]connector.Connector),
-		first:      "",
-		uids:       make(map[uint32]string),
-		subscriber: make(map[hash.Hash]map[string]Subscriber),
-		choosers:   make([]uint8, 0)}
+	b := &Bricker{
+		lock:            new(sync.RWMutex),
+		stop:            new(sync.Once),
+		connection:      make(map[string]connector.Connector),
+		sequences:       make(map[string]*connector.Sequence),
+		first:           "",
+		uids:            make(map[uint32]string),
+		subscriber:      make(map[hash.Hash]map[string]Subscriber),
+		choosers:        make([]uint8, 0),
+		requests:        make(map[request][]Subscriber),
+		waiting:         make(map[subscriberKey]request),
+		replays:         make(map[subscriberKey]replay),
+		settings:        make(map[setting]*packet.Packet),
+		statesubscriber: make(map[string]Subscriber),
+		mlock:           new(sync.Mutex),
+		mailboxes:       make(map[Subscriber][]*event.Event),
+		timeout:         DefaultTimeout,
+		events:          make(chan *event.Event),
+		quit:            make(chan struct{})}
+	go b.loop()
+	return b
 }
 
 // Done release all connections and subscriber and release all resources.
+// The dispatch loop stops, after Done no more events will be dispatched.
 func (b *Bricker) Done() {
-	// Unsubscribe all subscriber.
-	for _, subs := range b.subscriber {
-		for _, s := range subs {
-			b.Unsubscribe(s)
+	b.lock.RLock()
+	subs := make([]Subscriber, 0)
+	for _, s := range b.subscriber {
+		for _, sub := range s {
+			subs = append(subs, sub)
 		}
 	}
+	names := make([]string, 0, len(b.connection))
+	for name := range b.connection {
+		names = append(names, name)
+	}
+	b.lock.RUnlock()
+	// Unsubscribe all subscriber.
+	for _, s := range subs {
+		b.Unsubscribe(s)
+	}
 	// Release all connections.
-	for name, _ := range b.connection {
+	for _, name := range names {
 		b.Release(name)
 	}
+	b.stop.Do(func() { close(b.quit) })
+}
+
+// Internal method: loop is the dispatch loop, it is the only place where events are dispatched.
+func (b *Bricker) loop() {
+	for {
+		select {
+		case ev := <-b.events:
+			b.dispatch(ev)
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// Internal method: post forwards an event to the dispatch loop.
+// If the bricker is done, the event will be dropped.
+func (b *Bricker) post(e *event.Event) bool {
+	select {
+	case b.events <- e:
+		return true
+	case <-b.quit:
+		return false
+	}
 }
 
 // Internal method: read wait for a new event and forward it to the dispatcher.
@@ -75,54 +146,104 @@ func (b *Bricker) read(c connector.Connector, n string) {
 			return // done, no more packets
 		}
 		ev.ConnectorName = n
-		go b.dispatch(ev)
+		if !b.post(ev) {
+			return // bricker is done
+		}
 	}
 }
 
 // Internal method: write takes a event and send it to the right bricker (dispatch).
 func (b *Bricker) write(e *event.Event) {
 	if e != nil {
-		if conn, ok := b.connection[e.ConnectorName]; ok {
+		b.lock.RLock()
+		conn, ok := b.connection[e.ConnectorName]
+		b.lock.RUnlock()
+		if ok {
 			conn.Send(e)
 		} else {
 			e.Err = NewError(ErrorConnectorNameNotExists)
-			go b.dispatch(e)
+			b.post(e)
 		}
 	}
 }
 
-// Internal method: process dispatch the event to the right subscriber.
+// Internal method: dispatch determine the subscriber for the event and notify them.
+// A response (sequence is not 0) goes to the subscriber, which waits for the response of his request.
+// Callbacks (sequence 0) and responses without a waiting subscriber are matched by the hashes,
+// subscriber, which waits for the response of his own request, are left out.
+// Subscriber, which are not a callback, will be unsubscribed before they are notified,
+// so they get only one event.
+// State events of the connectors go to the state subscriber.
 func (b *Bricker) dispatch(e *event.Event) {
 	var h hash.Hash
-	if e.Packet == nil { // without a packet, no subscriber could be determined
-		go b.process(e, b.defaultsubscriber)
-	} else {
-		match := false
-		for _, chooser := range b.choosers {
-			h = hash.New(chooser, e.Packet.Head.Uid, e.Packet.Head.FunctionID)
-			if s, ok := b.subscriber[h]; ok {
-				match = true && (len(s) > 0)
-				go func(ev *event.Event, subs map[string]Subscriber) {
-					for _, s := range subs {
-						go b.process(e, s)
-					}
-				}(e, s)
+	subs := make([]Subscriber, 0)
+	b.lock.Lock()
+	if e.IsState() {
+		subs = b.dispatchState(e)
+	} else if e.Packet != nil { // without a packet, no subscriber could be determined
+		if e.Packet.Head.Sequence() != 0 {
+			if s := b.takeRequest(requestOf(e.ConnectorName, e.Packet)); s != nil {
+				subs = append(subs, s)
+				b.remember(e, s)
+				if !s.Subscription().Callback { // not a callback, call only once
+					b.unsubscribe(s.Subscription().Hash(), s.Id())
+				}
 			}
 		}
-		if !match { // no subscriber hash matched against packet hash
-			go b.process(e, b.defaultsubscriber)
+		if len(subs) == 0 { // no waiting subscriber for the response, try the hashes
+			for _, chooser := range b.choosers {
+				h = hash.New(chooser, e.Packet.Head.Uid, e.Packet.Head.FunctionID)
+				for _, s := range b.subscriber[h] {
+					if _, ok := b.waiting[keyOf(s)]; ok { // waits for his own response
+						continue
+					}
+					subs = append(subs, s)
+					if !s.Subscription().Callback { // not a callback, call only once
+						b.unsubscribe(h, s.Id())
+					}
+				}
+			}
 		}
 	}
+	if len(subs) == 0 { // no subscriber hash matched against packet hash
+		subs = append(subs, b.defaultsubscriber)
+	}
+	b.lock.Unlock()
+	for _, s := range subs {
+		b.deliver(e, s)
+	}
 }
 
-// Internal method: process notify given subscriber.
-func (b *Bricker) process(e *event.Event, sub Subscriber) {
+// Internal method: deliver puts the event into the mailbox of the subscriber.
+// If no delivery for the subscriber runs, a new one starts, otherwise the event waits in the mailbox,
+// so the subscriber gets his events one after another in the order of the dispatching.
+func (b *Bricker) deliver(e *event.Event, sub Subscriber) {
 	if sub == nil {
 		return // no subscriber, no notify
-	} else {
+	}
+	b.mlock.Lock()
+	defer b.mlock.Unlock()
+	if box, ok := b.mailboxes[sub]; ok { // delivery runs
+		b.mailboxes[sub] = append(box, e)
+		return
+	}
+	b.mailboxes[sub] = nil
+	go b.process(e, sub)
+}
+
+// Internal method: process notify given subscriber with the event and all events of his mailbox.
+// After the mailbox is empty, the delivery stops.
+func (b *Bricker) process(e *event.Event, sub Subscriber) {
+	for e != nil {
 		sub.Notify(e)
-		if !sub.Subscription().Callback { // not a callback, call only once
-			b.Unsubscribe(sub)
+		b.mlock.Lock()
+		if box := b.mailboxes[sub]; len(box) > 0 {
+			e = box[0]
+			b.mailboxes[sub] = box[1:]
+		} else {
+			e = nil
+			delete(b.mailboxes, sub)
 		}
+		b.mlock.Unlock()
 	}
 }
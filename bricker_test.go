@@ -0,0 +1,240 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/subscription"
+	"github.com/dirkjabl/bricker/util/hash"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testSubscriber is a minimal subscriber for the tests.
+type testSubscriber struct {
+	id     string
+	sub    *subscription.Subscription
+	notify func(*event.Event)
+}
+
+func (t *testSubscriber) Id() string                               { return t.id }
+func (t *testSubscriber) Subscription() *subscription.Subscription { return t.sub }
+func (t *testSubscriber) Notify(e *event.Event)                    { t.notify(e) }
+
+// echoGenerator answers every packet with the same packet.
+func echoGenerator(e *event.Event) *event.Event {
+	return event.NewPacket(e.Packet)
+}
+
+// waitFor waits until the wait group is done or fails after the timeout.
+func waitFor(t *testing.T, wg *sync.WaitGroup, timeout time.Duration, name string) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatalf("Error %s: timeout while waiting for events.", name)
+	}
+}
+
+func TestNew(t *testing.T) {
+	b := New()
+	if b == nil {
+		t.Fatal("Error TestNew: New do not create a object (nil pointer).")
+	}
+	b.Done()
+	b.Done() // a second done should not panic
+}
+
+func TestAttachRelease(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	if err := b.Attach(v, "virtual"); err != nil {
+		t.Fatalf("Error TestAttachRelease: could not attach connector (%s).", err)
+	}
+	if err := b.Attach(v, "virtual"); err == nil {
+		t.Fatal("Error TestAttachRelease: connector with same name attached twice.")
+	}
+	if err := b.Release("virtual"); err != nil {
+		t.Fatalf("Error TestAttachRelease: could not release connector (%s).", err)
+	}
+	if err := b.Release("virtual"); err == nil {
+		t.Fatal("Error TestAttachRelease: connector released twice.")
+	}
+}
+
+func TestSubscribeUnknownConnector(t *testing.T) {
+	b := New()
+	defer b.Done()
+	result := make(chan error, 1)
+	s := &testSubscriber{
+		id:     "unknown",
+		sub:    subscription.New(hash.ChoosenFunctionIDUid, 1, 1, packet.NewSimpleHeaderOnly(1, 1, true), false),
+		notify: func(e *event.Event) { result <- e.Err }}
+	if err := b.Subscribe(s, "unknown"); err != nil {
+		t.Fatalf("Error TestSubscribeUnknownConnector: could not subscribe (%s).", err)
+	}
+	select {
+	case err := <-result:
+		if e, ok := err.(Error); !ok || e.Code != ErrorConnectorNameNotExists {
+			t.Fatalf("Error TestSubscribeUnknownConnector: wrong error (%v).", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestSubscribeUnknownConnector: no event for subscriber.")
+	}
+}
+
+func TestConcurrentCallbacks(t *testing.T) {
+	const senders, events = 20, 250
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	v.AttachFallbackGenerator(echoGenerator)
+	b.Attach(v, "virtual")
+	wg := new(sync.WaitGroup)
+	wg.Add(senders * events)
+	s := &testSubscriber{
+		id:     "callback",
+		sub:    subscription.New(hash.ChoosenFunctionIDUid, 1, 8, nil, true),
+		notify: func(e *event.Event) { wg.Done() }}
+	b.Subscribe(s, "virtual")
+	for i := 0; i < senders; i++ {
+		go func() {
+			for j := 0; j < events; j++ {
+				v.Send(event.NewPacket(packet.NewSimpleHeaderOnly(1, 8, false)))
+			}
+		}()
+	}
+	waitFor(t, wg, 20*time.Second, "TestConcurrentCallbacks")
+}
+
+func TestCallbackOrder(t *testing.T) {
+	const events = 500
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	b.Attach(v, "virtual")
+	wg := new(sync.WaitGroup)
+	wg.Add(events)
+	values := make([]uint32, 0, events)
+	s := &testSubscriber{
+		id:  "callback",
+		sub: subscription.New(hash.ChoosenFunctionIDUid, 1, 8, nil, true),
+		notify: func(e *event.Event) {
+			var n uint32
+			e.Packet.Payload.Decode(&n)
+			if n%50 == 0 {
+				time.Sleep(time.Millisecond) // a slow handler
+			}
+			values = append(values, n)
+			wg.Done()
+		}}
+	b.Subscribe(s, "virtual")
+	for i := 0; i < events; i++ {
+		v.Emit(event.NewPacket(packet.NewSimpleHeaderPayload(1, 8, false, uint32(i))))
+	}
+	waitFor(t, wg, 20*time.Second, "TestCallbackOrder")
+	for i, n := range values {
+		if n != uint32(i) {
+			t.Fatalf("Error TestCallbackOrder: callback %d delivered as %d.", n, i)
+		}
+	}
+	for i := 0; ; i++ { // the delivery ends shortly after the last notify
+		b.mlock.Lock()
+		l := len(b.mailboxes)
+		b.mlock.Unlock()
+		if l == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Error TestCallbackOrder: %d mailboxes left after delivery.", l)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	const requests = 2000
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	v.AttachFallbackGenerator(echoGenerator)
+	b.Attach(v, "virtual")
+	wg := new(sync.WaitGroup)
+	wg.Add(requests)
+	lock := new(sync.Mutex)
+	counts := make(map[string]int)
+	for i := 0; i < requests; i++ {
+		go func(uid uint32) {
+			s := &testSubscriber{
+				id:  fmt.Sprintf("request %d", uid),
+				sub: subscription.New(hash.ChoosenFunctionIDUid, uid, 1, packet.NewSimpleHeaderOnly(uid, 1, true), false)}
+			s.notify = func(e *event.Event) {
+				lock.Lock()
+				counts[s.id]++
+				lock.Unlock()
+				wg.Done()
+			}
+			if err := b.Subscribe(s, "virtual"); err != nil {
+				t.Errorf("Error TestConcurrentRequests: could not subscribe (%s).", err)
+				wg.Done()
+			}
+		}(uint32(i + 1))
+	}
+	waitFor(t, wg, 20*time.Second, "TestConcurrentRequests")
+	lock.Lock()
+	defer lock.Unlock()
+	for id, c := range counts {
+		if c != 1 {
+			t.Fatalf("Error TestConcurrentRequests: subscriber %s notified %d times.", id, c)
+		}
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if len(b.subscriber) != 0 {
+		t.Fatalf("Error TestConcurrentRequests: %d subscriber left after notify.", len(b.subscriber))
+	}
+}
+
+func TestConcurrentSubscribeDone(t *testing.T) {
+	const workers, rounds = 10, 100
+	b := New()
+	v := virtual.New()
+	v.AttachFallbackGenerator(echoGenerator)
+	b.Attach(v, "virtual")
+	b.SubscribeDefaultFallback(&testSubscriber{id: "fallback", notify: func(e *event.Event) {}})
+	wg := new(sync.WaitGroup)
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func(w int) {
+			defer wg.Done()
+			for j := 0; j < rounds; j++ {
+				s := &testSubscriber{
+					id:     fmt.Sprintf("worker %d round %d", w, j),
+					sub:    subscription.New(hash.ChoosenFunctionIDUid, uint32(w), 8, nil, true),
+					notify: func(e *event.Event) {}}
+				b.Subscribe(s, "virtual")
+				v.Send(event.NewPacket(packet.NewSimpleHeaderOnly(uint32(w), 8, false)))
+				v.Send(event.NewPacket(packet.NewSimpleHeaderOnly(uint32(w), 9, false)))
+				b.Unsubscribe(s)
+			}
+		}(i)
+	}
+	wg.Wait()
+	b.Done()
+	v.Done()
+}
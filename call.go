@@ -0,0 +1,147 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"context"
+	"fmt"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/subscription"
+	"github.com/dirkjabl/bricker/util/generator"
+	"github.com/dirkjabl/bricker/util/hash"
+	"time"
+)
+
+// DefaultTimeout is the timeout of a new bricker for synchronized calls.
+const DefaultTimeout = 2500 * time.Millisecond
+
+// internal generator for call subscriber ids
+var gen = generator.New()
+
+// Timeout returns the actual timeout for synchronized calls. (Getter)
+// A timeout of zero means no timeout.
+func (b *Bricker) Timeout() time.Duration {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.timeout
+}
+
+// SetTimeout stores the timeout for synchronized calls. (Setter)
+// A timeout of zero (or less) means no timeout, a call waits until the response comes in.
+func (b *Bricker) SetTimeout(t time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.timeout = t
+}
+
+// TimeoutContext creates a context, which is canceled after the timeout of the bricker.
+// The cancel function should be called, if the context is not longer needed.
+func (b *Bricker) TimeoutContext() (context.Context, context.CancelFunc) {
+	if t := b.Timeout(); t > 0 {
+		return context.WithTimeout(context.Background(), t)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// CallSubscriber subscribes the given subscriber and waits for the first event of it.
+// The event is returned and not given to the subscriber (Notify will not called).
+// If the context is done before an event comes in, the subscriber will be unsubscribed
+// and a timeout error (ErrorTimeout) or a canceled error (ErrorCanceled) is the result.
+// A callback subscriber is unsubscribed after the first event.
+func (b *Bricker) CallSubscriber(ctx context.Context, s Subscriber, dest interface{}) (*event.Event, error) {
+	if err := ctx.Err(); err != nil { // done before start, send nothing
+		return nil, contextError(err)
+	}
+	cs := newCallSubscriber(s.Id(), s.Subscription())
+	if err := b.Subscribe(cs, dest); err != nil {
+		return nil, err
+	}
+	select {
+	case ev := <-cs.result:
+		if cs.Subscription().Callback {
+			b.Unsubscribe(cs)
+		}
+		return ev, nil
+	case <-ctx.Done():
+		b.Unsubscribe(cs)
+		return nil, contextError(ctx.Err())
+	}
+}
+
+// Call sends a request with the function id (fid) to the device with the uid and waits for the response.
+// The destination (dest) identifies the connector like by Subscribe.
+// The request is the data for the payload of the packet, it could be nil for a packet without payload.
+// The payload of the response will be decoded into the result, if the result is not nil.
+// A result with a FromPacket method (like a resulter of a device) converts the packet by itself.
+// The call honours the deadline and the cancellation of the context.
+func (b *Bricker) Call(ctx context.Context, dest interface{}, uid uint32, fid uint8, request, result interface{}) error {
+	var p *packet.Packet
+	if request == nil {
+		p = packet.NewSimpleHeaderOnly(uid, fid, true)
+	} else {
+		p = packet.NewSimpleHeaderPayload(uid, fid, true, request)
+	}
+	sub := subscription.New(hash.ChoosenFunctionIDUid, uid, fid, p, false)
+	ev, err := b.CallSubscriber(ctx, newCallSubscriber(fmt.Sprintf("call %04d", gen.Get()), sub), dest)
+	if err != nil {
+		return err
+	}
+	if ev.Err != nil {
+		return ev.Err
+	}
+	if ev.Packet == nil {
+		return NewError(ErrorNoPacket)
+	}
+	switch r := result.(type) {
+	case nil:
+		return nil
+	case interface {
+		FromPacket(*packet.Packet) error
+	}:
+		return r.FromPacket(ev.Packet)
+	default:
+		return ev.Packet.Payload.Decode(r)
+	}
+}
+
+// Internal type: callSubscriber is the subscriber for a synchronized call.
+// It forwards the first event to the result channel, all others will be dropped.
+type callSubscriber struct {
+	id     string
+	sub    *subscription.Subscription
+	result chan *event.Event
+}
+
+// Internal method: newCallSubscriber creates a call subscriber with the given id and subscription.
+func newCallSubscriber(id string, sub *subscription.Subscription) *callSubscriber {
+	return &callSubscriber{id: id, sub: sub, result: make(chan *event.Event, 1)}
+}
+
+// Id returns the subscriber id.
+func (cs *callSubscriber) Id() string {
+	return cs.id
+}
+
+// Subscription returns the subscription of the call.
+func (cs *callSubscriber) Subscription() *subscription.Subscription {
+	return cs.sub
+}
+
+// Notify forwards the event to the waiting call.
+func (cs *callSubscriber) Notify(e *event.Event) {
+	select {
+	case cs.result <- e:
+	default: // already a result there
+	}
+}
+
+// Internal method: contextError converts the error of a context into a bricker error.
+func contextError(err error) error {
+	if err == context.DeadlineExceeded {
+		return NewError(ErrorTimeout)
+	}
+	return NewError(ErrorCanceled)
+}
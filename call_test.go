@@ -0,0 +1,87 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"context"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/util/hash"
+	"testing"
+	"time"
+)
+
+type testValue struct {
+	Value int16
+}
+
+func TestCall(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 1), func(e *event.Event) *event.Event {
+		return event.NewPacket(packet.NewSimpleHeaderPayload(1, 1, true, &testValue{Value: 1234}))
+	})
+	b.Attach(v, "virtual")
+	r := &testValue{}
+	if err := b.Call(context.Background(), "virtual", 1, 1, nil, r); err != nil {
+		t.Fatalf("Error TestCall: call failed (%s).", err)
+	}
+	if r.Value != 1234 {
+		t.Fatalf("Error TestCall: wrong result (%d != 1234).", r.Value)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New() // without generator, no response
+	defer v.Done()
+	b.Attach(v, "virtual")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := b.Call(ctx, "virtual", 1, 1, nil, nil)
+	if e, ok := err.(Error); !ok || !e.Timeout() {
+		t.Fatalf("Error TestCallTimeout: no timeout error (%v).", err)
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if len(b.subscriber) != 0 {
+		t.Fatalf("Error TestCallTimeout: %d subscriber left after timeout.", len(b.subscriber))
+	}
+}
+
+func TestCallCanceled(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	b.Attach(v, "virtual")
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := b.Call(ctx, "virtual", 1, 1, nil, nil)
+	if e, ok := err.(Error); !ok || e.Code != ErrorCanceled {
+		t.Fatalf("Error TestCallCanceled: no canceled error (%v).", err)
+	}
+}
+
+func TestTimeoutContext(t *testing.T) {
+	b := New()
+	defer b.Done()
+	if b.Timeout() != DefaultTimeout {
+		t.Fatalf("Error TestTimeoutContext: wrong default timeout (%s).", b.Timeout())
+	}
+	b.SetTimeout(0)
+	ctx, cancel := b.TimeoutContext()
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("Error TestTimeoutContext: context without timeout has a deadline.")
+	}
+}
@@ -0,0 +1,590 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/brick/dc"
+	"github.com/dirkjabl/bricker/device/brick/imu"
+	"github.com/dirkjabl/bricker/device/brick/master"
+	"github.com/dirkjabl/bricker/device/brick/servo"
+	"github.com/dirkjabl/bricker/device/brick/stepper"
+	"github.com/dirkjabl/bricker/device/bricklet/ambientlight"
+	"github.com/dirkjabl/bricker/device/bricklet/analogin"
+	"github.com/dirkjabl/bricker/device/bricklet/analogout"
+	"github.com/dirkjabl/bricker/device/bricklet/barometer"
+	"github.com/dirkjabl/bricker/device/bricklet/current12"
+	"github.com/dirkjabl/bricker/device/bricklet/current25"
+	"github.com/dirkjabl/bricker/device/bricklet/distanceir"
+	"github.com/dirkjabl/bricker/device/bricklet/distanceus"
+	"github.com/dirkjabl/bricker/device/bricklet/dualbutton"
+	"github.com/dirkjabl/bricker/device/bricklet/dualrelay"
+	"github.com/dirkjabl/bricker/device/bricklet/gps"
+	"github.com/dirkjabl/bricker/device/bricklet/humidity"
+	"github.com/dirkjabl/bricker/device/bricklet/industrialdigitalin4"
+	"github.com/dirkjabl/bricker/device/bricklet/industrialdigitalout4"
+	"github.com/dirkjabl/bricker/device/bricklet/industrialdual020ma"
+	"github.com/dirkjabl/bricker/device/bricklet/industrialquadrelay"
+	"github.com/dirkjabl/bricker/device/bricklet/io16"
+	"github.com/dirkjabl/bricker/device/bricklet/io4"
+	"github.com/dirkjabl/bricker/device/bricklet/joystick"
+	"github.com/dirkjabl/bricker/device/bricklet/lcd20x4"
+	"github.com/dirkjabl/bricker/device/bricklet/ledstrip"
+	"github.com/dirkjabl/bricker/device/bricklet/linearpoti"
+	"github.com/dirkjabl/bricker/device/bricklet/moisture"
+	"github.com/dirkjabl/bricker/device/bricklet/motiondetector"
+	"github.com/dirkjabl/bricker/device/bricklet/multitouch"
+	"github.com/dirkjabl/bricker/device/bricklet/piezobuzzer"
+	"github.com/dirkjabl/bricker/device/bricklet/piezospeaker"
+	"github.com/dirkjabl/bricker/device/bricklet/ptc"
+	"github.com/dirkjabl/bricker/device/bricklet/remoteswitch"
+	"github.com/dirkjabl/bricker/device/bricklet/rotaryencoder"
+	"github.com/dirkjabl/bricker/device/bricklet/rotarypoti"
+	"github.com/dirkjabl/bricker/device/bricklet/temperature"
+	"github.com/dirkjabl/bricker/device/bricklet/temperatureir"
+	"github.com/dirkjabl/bricker/device/bricklet/tilt"
+	"github.com/dirkjabl/bricker/device/bricklet/voltagecurrent"
+	"github.com/dirkjabl/bricker/util/ks0066"
+	"strconv"
+)
+
+// command is a command of a bricklet.
+type command struct {
+	args string // usage of the arguments after the uid
+	min  int    // minimal number of arguments
+	max  int    // maximal number of arguments
+	run  func(e *env, uid uint32, args []string) error
+}
+
+// Internal type: generator is a function of a bricklet package, which creates a device without extra arguments.
+type generator func(id string, uid uint32, handler func(device.Resulter, error)) *device.Device
+
+// Internal type: periodsetter is a function of a bricklet package, which sets a callback period.
+type periodsetter func(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device
+
+// defaultperiod is the callback period of a watch without a given period in ms.
+const defaultperiod = 1000
+
+// bricklets are the supported bricklets with their commands.
+var bricklets = map[string]map[string]command{
+	"temperature": {
+		"get":   getter(temperature.GetTemperature),
+		"watch": watcher(temperature.SetTemperatureCallbackPeriod, temperature.TemperaturePeriod)},
+	"ambientlight": {
+		"get":         getter(ambientlight.GetIlluminance),
+		"analog":      getter(ambientlight.GetAnalogValue),
+		"watch":       watcher(ambientlight.SetIlluminanceCallbackPeriod, ambientlight.IlluminancePeriod),
+		"watchanalog": watcher(ambientlight.SetAnalogValueCallbackPeriod, ambientlight.AnalogValuePeriod)},
+	"humidity": {
+		"get":         getter(humidity.GetHumidity),
+		"analog":      getter(humidity.GetAnalogValue),
+		"watch":       watcher(humidity.SetHumidityCallbackPeriod, humidity.HumidityPeriod),
+		"watchanalog": watcher(humidity.SetAnalogValueCallbackPeriod, humidity.AnalogValuePeriod)},
+	"analogin": {
+		"get":         getter(analogin.GetVoltage),
+		"analog":      getter(analogin.GetAnalogValue),
+		"watch":       watcher(analogin.SetVoltageCallbackPeriod, analogin.VoltagePeriod),
+		"watchanalog": watcher(analogin.SetAnalogValueCallbackPeriod, analogin.AnalogValuePeriod)},
+	"analogout": {
+		"get": getter(analogout.GetVoltage),
+		"set": {args: "<mV>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			v, err := parseUint(args[0], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(analogout.SetVoltage(id("set"), uid, &analogout.Voltage{Value: uint16(v)}, nil))
+		}}},
+	"barometer": {
+		"get":             getter(barometer.GetAirPressure),
+		"altitude":        getter(barometer.GetAltitude),
+		"chiptemperature": getter(barometer.GetChipTemperature),
+		"watch":           watcher(barometer.SetAirPressureCallbackPeriod, barometer.AirPressurePeriod),
+		"watchaltitude":   watcher(barometer.SetAltitudeCallbackPeriod, barometer.AltitudePeriod)},
+	"dc": {
+		"get": getter(dc.GetCurrentVelocity),
+		"set": {args: "<velocity -32767..32767>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			v, err := parseInt(args[0], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(dc.SetVelocity(id("set"), uid, &dc.Velocity{Value: int16(v)}, nil))
+		}},
+		"enable": {args: "<on|off>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			on, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			if on {
+				return e.call(dc.Enable(id("enable"), uid, nil))
+			}
+			return e.call(dc.Disable(id("disable"), uid, nil))
+		}},
+		"brake": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(dc.FullBrake(id("brake"), uid, nil))
+		}},
+		"current": getter(dc.GetCurrentConsumption)},
+	"imu": {
+		"get":        getter(imu.GetOrientation),
+		"quaternion": getter(imu.GetQuaternion),
+		"all":        getter(imu.GetAllData),
+		"watch":      watcher(imu.SetOrientationCallbackPeriod, imu.OrientationPeriod),
+		"watchall":   watcher(imu.SetAllDataCallbackPeriod, imu.AllDataPeriod),
+		"leds": {args: "<on|off>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			on, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			if on {
+				return e.call(imu.LedsOn(id("leds"), uid, nil))
+			}
+			return e.call(imu.LedsOff(id("leds"), uid, nil))
+		}}},
+	"master": {
+		"get":             getter(master.GetStackVoltage),
+		"current":         getter(master.GetStackCurrent),
+		"usb":             getter(master.GetUSBVoltage),
+		"chiptemperature": getter(master.GetChipTemperature),
+		"watch":           watcher(master.SetStackVoltageCallbackPeriod, master.StackVoltagePeriod),
+		"watchcurrent":    watcher(master.SetStackCurrentCallbackPeriod, master.StackCurrentPeriod)},
+	"servo": {
+		"get": {args: "<servo>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			return e.call(servo.GetCurrentPosition(id("get"), uid, servo.Servo(s), nil))
+		}},
+		"set": {args: "<servo> <position °/100>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			p, err := parseInt(args[1], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(servo.SetPosition(id("set"), uid, &servo.ServoPosition{Servo: servo.Servo(s), Position: int16(p)}, nil))
+		}},
+		"enable": {args: "<servo> <on|off>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			on, err := parseOnOff(args[1])
+			if err != nil {
+				return err
+			}
+			if on {
+				return e.call(servo.Enable(id("enable"), uid, servo.Servo(s), nil))
+			}
+			return e.call(servo.Disable(id("disable"), uid, servo.Servo(s), nil))
+		}},
+		"voltage": getter(servo.GetStackInputVoltage),
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			if err := e.call(servo.EnablePositionReachedCallback(id("enable"), uid, nil)); err != nil {
+				return err
+			}
+			return e.watch(servo.PositionReached(id("watch"), uid, e.printer()))
+		}}},
+	"stepper": {
+		"get": getter(stepper.GetAllData),
+		"target": {args: "<position>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			p, err := parseInt(args[0], 32)
+			if err != nil {
+				return err
+			}
+			return e.call(stepper.SetTargetPosition(id("target"), uid, &stepper.Position{Value: int32(p)}, nil))
+		}},
+		"stop": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(stepper.Stop(id("stop"), uid, nil))
+		}},
+		"watch": watcher(stepper.SetAllDataPeriod, stepper.AllDataPeriod)},
+	"joystick": {
+		"get":         getter(joystick.GetPosition),
+		"analog":      getter(joystick.GetAnalogValue),
+		"pressed":     getter(joystick.IsPressed),
+		"watch":       watcher(joystick.SetPositionCallbackPeriod, joystick.PositionPeriod),
+		"watchanalog": watcher(joystick.SetAnalogValueCallbackPeriod, joystick.AnalogValuePeriod),
+		"watchbutton": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(
+				joystick.Pressed(id("pressed"), uid, e.printer()),
+				joystick.Released(id("released"), uid, e.printer()))
+		}}},
+	"linearpoti": {
+		"get":         getter(linearpoti.GetPosition),
+		"analog":      getter(linearpoti.GetAnalogValue),
+		"watch":       watcher(linearpoti.SetPositionCallbackPeriod, linearpoti.PositionPeriod),
+		"watchanalog": watcher(linearpoti.SetAnalogValueCallbackPeriod, linearpoti.AnalogValuePeriod)},
+	"rotarypoti": {
+		"get":         getter(rotarypoti.GetPosition),
+		"analog":      getter(rotarypoti.GetAnalogValue),
+		"watch":       watcher(rotarypoti.SetPositionCallbackPeriod, rotarypoti.PositionPeriod),
+		"watchanalog": watcher(rotarypoti.SetAnalogValueCallbackPeriod, rotarypoti.AnalogValuePeriod)},
+	"rotaryencoder": {
+		"get": {args: "[reset]", max: 1, run: func(e *env, uid uint32, args []string) error {
+			reset := len(args) > 0
+			if reset && args[0] != "reset" {
+				return fmt.Errorf("invalid argument %q (reset)", args[0])
+			}
+			return e.call(rotaryencoder.GetCount(id("get"), uid, reset, nil))
+		}},
+		"pressed": getter(rotaryencoder.IsPressed),
+		"watch":   watcher(rotaryencoder.SetCountCallbackPeriod, rotaryencoder.CountPeriod),
+		"watchbutton": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(
+				rotaryencoder.Pressed(id("pressed"), uid, e.printer()),
+				rotaryencoder.Released(id("released"), uid, e.printer()))
+		}}},
+	"distanceir": {
+		"get":         getter(distanceir.GetDistance),
+		"analog":      getter(distanceir.GetAnalogValue),
+		"watch":       watcher(distanceir.SetDistanceCallbackPeriod, distanceir.DistancePeriod),
+		"watchanalog": watcher(distanceir.SetAnalogValueCallbackPeriod, distanceir.AnalogValuePeriod)},
+	"distanceus": {
+		"get":   getter(distanceus.GetDistanceValue),
+		"watch": watcher(distanceus.SetDistanceCallbackPeriod, distanceus.DistancePeriod)},
+	"current12": {
+		"get":         getter(current12.GetCurrent),
+		"analog":      getter(current12.GetAnalogValue),
+		"overcurrent": getter(current12.IsOverCurrent),
+		"calibrate": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(current12.Calibrate(id("calibrate"), uid, nil))
+		}},
+		"watch": watcher(current12.SetCurrentCallbackPeriod, current12.CurrentPeriod)},
+	"current25": {
+		"get":         getter(current25.GetCurrent),
+		"analog":      getter(current25.GetAnalogValue),
+		"overcurrent": getter(current25.IsOverCurrent),
+		"calibrate": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(current25.Calibrate(id("calibrate"), uid, nil))
+		}},
+		"watch": watcher(current25.SetCurrentCallbackPeriod, current25.CurrentPeriod)},
+	"voltagecurrent": {
+		"get":          getter(voltagecurrent.GetCurrent),
+		"voltage":      getter(voltagecurrent.GetVoltage),
+		"power":        getter(voltagecurrent.GetPower),
+		"config":       getter(voltagecurrent.GetConfiguration),
+		"watch":        watcher(voltagecurrent.SetCurrentCallbackPeriod, voltagecurrent.CurrentPeriod),
+		"watchvoltage": watcher(voltagecurrent.SetVoltageCallbackPeriod, voltagecurrent.VoltagePeriod),
+		"watchpower":   watcher(voltagecurrent.SetPowerCallbackPeriod, voltagecurrent.PowerPeriod)},
+	"ledstrip": {
+		"voltage":  getter(ledstrip.GetSupplyVoltage),
+		"duration": getter(ledstrip.GetFrameDuration),
+		"chip":     getter(ledstrip.GetChipType),
+		"fill": {args: "<leds> <r> <g> <b>", min: 4, max: 4, run: func(e *env, uid uint32, args []string) error {
+			n, err := parseUint(args[0], 16)
+			if err != nil {
+				return err
+			}
+			var rgb [3]uint8
+			for i := range rgb {
+				c, err := parseUint(args[i+1], 8)
+				if err != nil {
+					return err
+				}
+				rgb[i] = uint8(c)
+			}
+			fb := ledstrip.NewFrameBuffer(int(n))
+			fb.Fill(ledstrip.Color{R: rgb[0], G: rgb[1], B: rgb[2]})
+			return fb.Write(e.brick, connectorname, uid)
+		}}},
+	"gps": {
+		"get":      getter(gps.GetCoordinates),
+		"status":   getter(gps.GetStatus),
+		"altitude": getter(gps.GetAltitude),
+		"motion":   getter(gps.GetMotion),
+		"time":     getter(gps.GetDateTime),
+		"watch":    watcher(gps.SetCoordinatesCallbackPeriod, gps.CoordinatesPeriod)},
+	"ptc": {
+		"get":        getter(ptc.GetTemperature),
+		"resistance": getter(ptc.GetResistance),
+		"connected":  getter(ptc.IsSensorConnected),
+		"watch":      watcher(ptc.SetTemperatureCallbackPeriod, ptc.TemperaturePeriod)},
+	"temperatureir": {
+		"get":          getter(temperatureir.GetObjectTemperature),
+		"ambient":      getter(temperatureir.GetAmbientTemperature),
+		"emissivity":   getter(temperatureir.GetEmissivity),
+		"watch":        watcher(temperatureir.SetObjectTemperatureCallbackPeriod, temperatureir.ObjectTemperaturePeriod),
+		"watchambient": watcher(temperatureir.SetAmbientTemperatureCallbackPeriod, temperatureir.AmbientTemperaturePeriod)},
+	"moisture": {
+		"get":   getter(moisture.GetMoistureValue),
+		"watch": watcher(moisture.SetMoistureCallbackPeriod, moisture.MoisturePeriod)},
+	"dualrelay": {
+		"get": getter(dualrelay.GetState),
+		"set": {args: "<on|off> <on|off>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			r1, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			r2, err := parseOnOff(args[1])
+			if err != nil {
+				return err
+			}
+			return e.call(dualrelay.SetState(id("set"), uid, &dualrelay.State{Relay1: r1, Relay2: r2}, nil))
+		}}},
+	"io4": {
+		"get": getter(io4.GetValue),
+		"set": {args: "<mask>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			m, err := parseUint(args[0], 4)
+			if err != nil {
+				return err
+			}
+			return e.call(io4.SetValue(id("set"), uid, &io4.Value{Mask: uint8(m)}, nil))
+		}}},
+	"industrialdigitalin4": {
+		"get": getter(industrialdigitalin4.GetValue),
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(industrialdigitalin4.InterruptTrigger(id("interrupt"), uid, e.printer()))
+		}}},
+	"industrialdigitalout4": {
+		"get": getter(industrialdigitalout4.GetValue),
+		"set": {args: "<mask>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			m, err := parseUint(args[0], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(industrialdigitalout4.SetValue(id("set"), uid, &industrialdigitalout4.Value{Mask: uint16(m)}, nil))
+		}}},
+	"industrialdual020ma": {
+		"get": {args: "<sensor>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			return e.call(industrialdual020ma.GetCurrent(id("get"), uid, uint8(s), nil))
+		}},
+		"connected": {args: "<sensor>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			return e.call(industrialdual020ma.IsSensorConnected(id("connected"), uid, uint8(s), nil))
+		}},
+		"watch": {args: "<sensor> [period ms]", min: 1, max: 2, run: func(e *env, uid uint32, args []string) error {
+			s, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			period := uint64(defaultperiod)
+			if len(args) > 1 {
+				if period, err = parseUint(args[1], 32); err != nil {
+					return err
+				}
+			}
+			sp := &industrialdual020ma.SensorPeriod{Sensor: uint8(s), Period: device.Period{Value: uint32(period)}}
+			if err := e.call(industrialdual020ma.SetCurrentCallbackPeriod(id("period"), uid, sp, nil)); err != nil {
+				return err
+			}
+			return e.watch(industrialdual020ma.CurrentPeriod(id("watch"), uid, e.printer()))
+		}}},
+	"industrialquadrelay": {
+		"get": getter(industrialquadrelay.GetValue),
+		"set": {args: "<mask>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			m, err := parseUint(args[0], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(industrialquadrelay.SetValue(id("set"), uid, &industrialquadrelay.Value{Mask: uint16(m)}, nil))
+		}}},
+	"multitouch": {
+		"get": getter(multitouch.GetTouchState),
+		"recalibrate": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(multitouch.Recalibrate(id("recalibrate"), uid, nil))
+		}},
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(multitouch.TouchStateChanged(id("watch"), uid, e.printer()))
+		}}},
+	"remoteswitch": {
+		"state": getter(remoteswitch.GetSwitchingState),
+		"switcha": {args: "<house code> <receiver code> <on|off>", min: 3, max: 3, run: func(e *env, uid uint32, args []string) error {
+			h, err := parseUint(args[0], 5)
+			if err != nil {
+				return err
+			}
+			r, err := parseUint(args[1], 5)
+			if err != nil {
+				return err
+			}
+			on, err := parseOnOff(args[2])
+			if err != nil {
+				return err
+			}
+			s := &remoteswitch.SocketA{HouseCode: uint8(h), ReceiverCode: uint8(r), SwitchTo: remoteswitch.SwitchToOff}
+			if on {
+				s.SwitchTo = remoteswitch.SwitchToOn
+			}
+			return e.call(remoteswitch.SwitchSocketA(id("switcha"), uid, s, nil))
+		}}},
+	"io16": {
+		"get": {args: "<a|b>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			p, err := parsePort(args[0])
+			if err != nil {
+				return err
+			}
+			return e.call(io16.GetPort(id("get"), uid, &io16.Port{Value: p}, nil))
+		}},
+		"set": {args: "<a|b> <mask>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			p, err := parsePort(args[0])
+			if err != nil {
+				return err
+			}
+			m, err := parseUint(args[1], 8)
+			if err != nil {
+				return err
+			}
+			return e.call(io16.SetPort(id("set"), uid, &io16.PortValue{Port: p, ValueMask: uint8(m)}, nil))
+		}}},
+	"lcd20x4": {
+		"write": {args: "<line> <position> <text>", min: 3, max: 3, run: func(e *env, uid uint32, args []string) error {
+			l, err := parseUint(args[0], 8)
+			if err != nil {
+				return err
+			}
+			p, err := parseUint(args[1], 8)
+			if err != nil {
+				return err
+			}
+			if l > 3 || p > 19 {
+				return fmt.Errorf("line (0-3) or position (0-19) out of range")
+			}
+			return e.call(lcd20x4.WriteLine(id("write"), uid, ks0066.NewLcdTextLine(uint8(l), uint8(p), args[2]), nil))
+		}},
+		"clear": {run: func(e *env, uid uint32, args []string) error {
+			return e.call(lcd20x4.ClearDisplay(id("clear"), uid, nil))
+		}},
+		"backlight": {args: "<on|off>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			on, err := parseOnOff(args[0])
+			if err != nil {
+				return err
+			}
+			if on {
+				return e.call(lcd20x4.BacklightOn(id("backlight"), uid, nil))
+			}
+			return e.call(lcd20x4.BacklightOff(id("backlight"), uid, nil))
+		}}},
+	"tilt": {
+		"get": getter(tilt.GetTiltState),
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			if err := e.call(tilt.EnableTiltStateCallback(id("enable"), uid, nil)); err != nil {
+				return err
+			}
+			return e.watch(tilt.TiltStateChanged(id("watch"), uid, e.printer()))
+		}}},
+	"motiondetector": {
+		"get": getter(motiondetector.GetMotionDetected),
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(
+				motiondetector.MotionDetected(id("detected"), uid, e.printer()),
+				motiondetector.DetectionCycleEnded(id("ended"), uid, e.printer()))
+		}}},
+	"dualbutton": {
+		"get": getter(dualbutton.GetButtonState),
+		"watch": {run: func(e *env, uid uint32, args []string) error {
+			return e.watch(dualbutton.StateChanged(id("watch"), uid, e.printer()))
+		}}},
+	"piezobuzzer": {
+		"beep": {args: "<ms>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			d, err := parseUint(args[0], 32)
+			if err != nil {
+				return err
+			}
+			return e.call(piezobuzzer.Beep(id("beep"), uid, &piezobuzzer.Beeps{Duration: uint32(d)}, nil))
+		}},
+		"morse": {args: "<code>", min: 1, max: 1, run: func(e *env, uid uint32, args []string) error {
+			m := &piezobuzzer.Morse{}
+			copy(m.Code[:], args[0])
+			return e.call(piezobuzzer.MorseCode(id("morse"), uid, m, nil))
+		}}},
+	"piezospeaker": {
+		"beep": {args: "<ms> <hz>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			d, err := parseUint(args[0], 32)
+			if err != nil {
+				return err
+			}
+			f, err := parseUint(args[1], 16)
+			if err != nil {
+				return err
+			}
+			return e.call(piezospeaker.Beep(id("beep"), uid,
+				&piezospeaker.Beeps{Duration: uint32(d), Frequency: uint16(f)}, nil))
+		}},
+		"morse": {args: "<code> <hz>", min: 2, max: 2, run: func(e *env, uid uint32, args []string) error {
+			f, err := parseUint(args[1], 16)
+			if err != nil {
+				return err
+			}
+			m := &piezospeaker.Morse{Frequency: uint16(f)}
+			copy(m.Code[:], args[0])
+			return e.call(piezospeaker.MorseCode(id("morse"), uid, m, nil))
+		}}},
+}
+
+// getter creates a command, which calls the generator and prints the result.
+func getter(g generator) command {
+	return command{run: func(e *env, uid uint32, args []string) error {
+		return e.call(g(id("get"), uid, nil))
+	}}
+}
+
+// watcher creates a command, which sets the callback period (optional argument in ms)
+// and prints every value of the callback.
+func watcher(set periodsetter, callback generator) command {
+	return command{args: "[period ms]", max: 1, run: func(e *env, uid uint32, args []string) error {
+		period := uint64(defaultperiod)
+		if len(args) > 0 {
+			var err error
+			if period, err = parseUint(args[0], 32); err != nil {
+				return err
+			}
+		}
+		if err := e.call(set(id("period"), uid, &device.Period{Value: uint32(period)}, nil)); err != nil {
+			return err
+		}
+		return e.watch(callback(id("watch"), uid, e.printer()))
+	}}
+}
+
+// id creates a unique subscriber id for the command.
+func id(name string) string {
+	return "bricker" + name + device.GenId()
+}
+
+// parseUint converts a argument into a number with the given bit size.
+func parseUint(s string, bits int) (uint64, error) {
+	v, err := strconv.ParseUint(s, 0, bits)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q (%d bit)", s, bits)
+	}
+	return v, nil
+}
+
+// parseInt converts a argument into a signed number with the given bit size.
+func parseInt(s string, bits int) (int64, error) {
+	v, err := strconv.ParseInt(s, 0, bits)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q (%d bit)", s, bits)
+	}
+	return v, nil
+}
+
+// parseOnOff converts a argument (on or off) into a bool.
+func parseOnOff(s string) (bool, error) {
+	switch s {
+	case "on", "1", "true":
+		return true, nil
+	case "off", "0", "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid state %q (on or off)", s)
+}
+
+// parsePort converts a argument (a or b) into a port of the io16 bricklet.
+func parsePort(s string) (byte, error) {
+	if s != "a" && s != "b" {
+		return 0, fmt.Errorf("invalid port %q (a or b)", s)
+	}
+	return s[0], nil
+}
@@ -0,0 +1,322 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Command bricker explores and controls the stacks of a brick daemon.
+
+Usage:
+
+	bricker [flags] enumerate
+	bricker [flags] watch
+	bricker [flags] <bricklet> <command> <uid> [arguments]
+
+The flags must stand before the command:
+
+	--host     host of the brick daemon (default localhost)
+	--port     port of the brick daemon (default 4223)
+	--secret   secret for the authentication
+	--json     output as JSON (one object per line)
+	--timeout  timeout for a call or the enumeration (default 2.5s)
+	--duration duration of a watch, 0 watches until interrupted
+
+Examples:
+
+	bricker enumerate
+	bricker temperature get XYZ
+	bricker --json temperature watch XYZ 500
+	bricker lcd20x4 write XYZ 0 0 "hello"
+
+A call without arguments prints all bricklets and their commands.
+*/
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/buffered"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/name"
+	"github.com/dirkjabl/bricker/device/registry"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/base58"
+	"github.com/dirkjabl/bricker/subscription"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+)
+
+// connectorname is the name of the connector to the brick daemon.
+const connectorname = "stack"
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("bricker", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	host := flags.String("host", "localhost", "host of the brick daemon")
+	port := flags.Int("port", 4223, "port of the brick daemon")
+	secret := flags.String("secret", "", "secret for the authentication")
+	asjson := flags.Bool("json", false, "output as JSON")
+	timeout := flags.Duration("timeout", bricker.DefaultTimeout, "timeout for a call or the enumeration")
+	duration := flags.Duration("duration", 0, "duration of a watch, 0 watches until interrupted")
+	flags.Usage = func() { usage(flags, stderr) }
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		usage(flags, stderr)
+		return 2
+	}
+	cmd, err := lookup(flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "bricker: %s\n", err)
+		return 2
+	}
+	conn, err := buffered.NewAuthenticated(fmt.Sprintf("%s:%d", *host, *port), *secret, 10, 10, nil)
+	if err != nil {
+		fmt.Fprintf(stderr, "bricker: no connection: %s\n", err)
+		return 1
+	}
+	defer conn.Done()
+	brick := bricker.New()
+	defer brick.Done()
+	brick.SetTimeout(*timeout)
+	brick.Attach(conn, connectorname)
+	e := &env{brick: brick, json: *asjson, out: stdout, duration: *duration}
+	if err = cmd(e); err != nil {
+		fmt.Fprintf(stderr, "bricker: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+// lookup finds the command for the arguments.
+func lookup(args []string) (func(*env) error, error) {
+	switch args[0] {
+	case "enumerate":
+		return enumerate, nil
+	case "watch":
+		return watchAll, nil
+	}
+	b, ok := bricklets[args[0]]
+	if !ok {
+		return nil, fmt.Errorf("unknown command or bricklet %q", args[0])
+	}
+	if len(args) < 3 {
+		return nil, fmt.Errorf("usage: bricker %s <command> <uid> [arguments]", args[0])
+	}
+	c, ok := b[args[1]]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %q for %s", args[1], args[0])
+	}
+	uid, err := parseUid(args[2])
+	if err != nil {
+		return nil, err
+	}
+	if len(args)-3 < c.min || len(args)-3 > c.max {
+		return nil, fmt.Errorf("usage: bricker %s %s <uid> %s", args[0], args[1], c.args)
+	}
+	return func(e *env) error { return c.run(e, uid, args[3:]) }, nil
+}
+
+// usage prints the flags, bricklets and their commands.
+func usage(flags *flag.FlagSet, w io.Writer) {
+	fmt.Fprintln(w, "usage: bricker [flags] enumerate | watch | <bricklet> <command> <uid> [arguments]")
+	flags.PrintDefaults()
+	names := make([]string, 0, len(bricklets))
+	for n := range bricklets {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		cmds := make([]string, 0, len(bricklets[n]))
+		for c := range bricklets[n] {
+			cmds = append(cmds, c)
+		}
+		sort.Strings(cmds)
+		for _, c := range cmds {
+			fmt.Fprintf(w, "  %s %s <uid> %s\n", n, c, bricklets[n][c].args)
+		}
+	}
+}
+
+// parseUid converts a base58 uid into the uid number.
+func parseUid(s string) (uint32, error) {
+	if s == "" || len(s) > 8 {
+		return 0, fmt.Errorf("invalid uid %q", s)
+	}
+	for _, r := range s {
+		if !strings.ContainsRune(base58.Base58Alphabet, r) {
+			return 0, fmt.Errorf("invalid uid %q", s)
+		}
+	}
+	return base58.Convert32(base58.DecodeString(s)), nil
+}
+
+// env is the environment of a command.
+type env struct {
+	brick    *bricker.Bricker
+	json     bool
+	out      io.Writer
+	duration time.Duration
+}
+
+// call runs the subscriber synchronized and prints the result.
+// An empty result of a setter is not printed.
+func (e *env) call(d *device.Device) error {
+	result, err := device.Future(e.brick, connectorname, d)
+	if err != nil {
+		return err
+	}
+	if _, ok := result.(*device.EmptyResult); !ok && result != nil {
+		e.print(result)
+	}
+	return nil
+}
+
+// print writes a value as text or as JSON.
+func (e *env) print(v interface{}) {
+	if e.json {
+		b, err := json.Marshal(v)
+		if err == nil {
+			fmt.Fprintln(e.out, string(b))
+			return
+		}
+	}
+	fmt.Fprintln(e.out, v)
+}
+
+// printer creates a handler, which prints every result.
+func (e *env) printer() func(device.Resulter, error) {
+	return func(r device.Resulter, err error) {
+		if err == nil && r != nil {
+			e.print(r)
+		}
+	}
+}
+
+// watch subscribes the callbacks and waits for the end of the watch.
+func (e *env) watch(subs ...*device.Device) error {
+	for _, s := range subs {
+		if err := e.brick.Subscribe(s, connectorname); err != nil {
+			return err
+		}
+	}
+	e.wait()
+	return nil
+}
+
+// wait waits until the duration is over or the user interrupts.
+func (e *env) wait() {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	var over <-chan time.Time
+	if e.duration > 0 {
+		over = time.After(e.duration)
+	}
+	select {
+	case <-interrupt:
+	case <-over:
+	}
+}
+
+// Device is the output of the enumerate command.
+type Device struct {
+	Uid          string `json:"uid"`
+	Name         string `json:"name"`
+	ConnectedUid string `json:"connected_uid"`
+	Position     string `json:"position"`
+	Hardware     string `json:"hardware"`
+	Firmware     string `json:"firmware"`
+	Identifer    uint16 `json:"device_identifier"`
+}
+
+// String fullfill the stringer interface.
+func (d *Device) String() string {
+	return fmt.Sprintf("%-8s %-36s %-8s %-2s %-8s %s", d.Uid, d.Name, d.ConnectedUid, d.Position, d.Hardware, d.Firmware)
+}
+
+// enumerate lists all devices of the stack.
+func enumerate(e *env) error {
+	r := registry.New(e.brick)
+	defer r.Done()
+	if err := r.Enumerate(connectorname); err != nil {
+		return err
+	}
+	time.Sleep(e.brick.Timeout())
+	devices := r.Devices()
+	if len(devices) == 0 {
+		return errors.New("no devices found")
+	}
+	if !e.json {
+		fmt.Fprintf(e.out, "%-8s %-36s %-8s %-2s %-8s %s\n", "UID", "Name", "Parent", "P", "Hardware", "Firmware")
+	}
+	for _, d := range devices {
+		en := d.Enumeration
+		e.print(&Device{
+			Uid:          misc.BytesToString(en.Uid[:]),
+			Name:         name.Name(en.DeviceIdentifer),
+			ConnectedUid: misc.BytesToString(en.ConnectedUid[:]),
+			Position:     string(en.Position),
+			Hardware:     fmt.Sprintf("%d.%d.%d", en.HardwareVersion[0], en.HardwareVersion[1], en.HardwareVersion[2]),
+			Firmware:     fmt.Sprintf("%d.%d.%d", en.FirmwareVersion[0], en.FirmwareVersion[1], en.FirmwareVersion[2]),
+			Identifer:    en.DeviceIdentifer})
+	}
+	return nil
+}
+
+// Callback is the output of the watch command.
+type Callback struct {
+	Time     time.Time `json:"time"`
+	Uid      string    `json:"uid"`
+	Function uint8     `json:"function"`
+	Payload  []byte    `json:"payload"`
+}
+
+// String fullfill the stringer interface.
+func (c *Callback) String() string {
+	return fmt.Sprintf("%s %-8s %3d % x", c.Time.Format("15:04:05.000"), c.Uid, c.Function, c.Payload)
+}
+
+// watchAll streams all callbacks of the stack.
+func watchAll(e *env) error {
+	e.brick.SubscribeDefaultFallback(&fallback{notify: func(ev *event.Event) {
+		if ev.Packet == nil || ev.Packet.Head == nil || ev.Packet.Head.Sequence() != 0 {
+			return // only callbacks
+		}
+		uid := base58.Encode(uint64(ev.Packet.Head.Uid))
+		e.print(&Callback{
+			Time:     ev.TimeStamp,
+			Uid:      misc.BytesToString(uid[:]),
+			Function: ev.Packet.Head.FunctionID,
+			Payload:  ev.Packet.Payload.Bytes()})
+	}})
+	e.wait()
+	return nil
+}
+
+// fallback is the default fallback subscriber for the watch command.
+type fallback struct {
+	notify func(*event.Event)
+}
+
+// Id fullfill the subscriber interface.
+func (f *fallback) Id() string { return "watch" }
+
+// Subscription fullfill the subscriber interface, the fallback needs no subscription.
+func (f *fallback) Subscription() *subscription.Subscription { return nil }
+
+// Notify fullfill the subscriber interface.
+func (f *fallback) Notify(e *event.Event) { f.notify(e) }
@@ -0,0 +1,82 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/dirkjabl/bricker/emulator"
+	"net"
+	"strings"
+	"testing"
+)
+
+func startEmulator(t *testing.T) (*emulator.Emulator, *emulator.Device, []string) {
+	emu, err := emulator.New("")
+	if err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	temp := emulator.Temperature("t")
+	emu.AddStack(emulator.Master("m"), temp, emulator.DualRelay("r"))
+	host, port, _ := net.SplitHostPort(emu.Addr())
+	return emu, temp, []string{"--host", host, "--port", port, "--timeout", "500ms"}
+}
+
+func TestEnumerate(t *testing.T) {
+	emu, _, flags := startEmulator(t)
+	defer emu.Done()
+	var out, errout bytes.Buffer
+	if code := run(append(flags, "--json", "enumerate"), &out, &errout); code != 0 {
+		t.Fatalf("Error: exit code %d (%s)", code, errout.String())
+	}
+	uids := make(map[string]*Device)
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		d := &Device{}
+		if err := json.Unmarshal([]byte(line), d); err != nil {
+			t.Fatalf("Error: %s (%s)", err, line)
+		}
+		uids[d.Uid] = d
+	}
+	if len(uids) != 3 {
+		t.Fatalf("Error: 3 devices expected, got %d", len(uids))
+	}
+	if d, ok := uids["t"]; !ok || d.ConnectedUid != "m" || d.Name == "" {
+		t.Fatalf("Error: wrong temperature bricklet %v", d)
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	emu, temp, flags := startEmulator(t)
+	defer emu.Done()
+	temp.Set(emulator.ValueTemperature, 2150)
+	var out, errout bytes.Buffer
+	if code := run(append(flags, "--json", "temperature", "get", "t"), &out, &errout); code != 0 {
+		t.Fatalf("Error: exit code %d (%s)", code, errout.String())
+	}
+	if s := strings.TrimSpace(out.String()); s != `{"Value":2150}` {
+		t.Fatalf("Error: wrong output %q", s)
+	}
+	out.Reset()
+	if code := run(append(flags, "dualrelay", "set", "r", "on", "off"), &out, &errout); code != 0 {
+		t.Fatalf("Error: exit code %d (%s)", code, errout.String())
+	}
+	if code := run(append(flags, "--json", "dualrelay", "get", "r"), &out, &errout); code != 0 {
+		t.Fatalf("Error: exit code %d (%s)", code, errout.String())
+	}
+	if s := strings.TrimSpace(out.String()); s != `{"Relay1":true,"Relay2":false}` {
+		t.Fatalf("Error: wrong output %q", s)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	var out, errout bytes.Buffer
+	tests := [][]string{{}, {"unknown"}, {"temperature", "get"}, {"temperature", "get", "0OIl"},
+		{"dualrelay", "set", "r", "on"}}
+	for _, args := range tests {
+		if code := run(args, &out, &errout); code != 2 {
+			t.Fatalf("Error: exit code 2 expected for %v, got %d", args, code)
+		}
+	}
+}
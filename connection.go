@@ -6,15 +6,19 @@ package bricker
 
 import (
 	"github.com/dirkjabl/bricker/connector"
+	"sort"
 )
 
 // AttachConnector adds a named connector to the bricker.
 // The name must be unique and should not used before.
 func (b *Bricker) Attach(c connector.Connector, n string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
 	if _, ok := b.connection[n]; ok { // name exists, no add
 		return NewError(ErrorConnectorNameExists)
 	}
 	b.connection[n] = c
+	b.sequences[n] = new(connector.Sequence)
 	if b.first == "" {
 		b.first = n
 	}
@@ -24,6 +28,8 @@ func (b *Bricker) Attach(c connector.Connector, n string) error {
 
 // ReleaseConnector take a connector from the bricker.
 func (b *Bricker) Release(n string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
 	if _, ok := b.connection[n]; !ok { // name does not exists
 		return NewError(ErrorNoConnectorToRelease)
 	}
@@ -31,12 +37,64 @@ func (b *Bricker) Release(n string) error {
 		b.first = ""
 	} // TODO: search for a new connector as first
 	delete(b.connection, n)
+	delete(b.sequences, n)
+	for uid, name := range b.uids { // devices are no more reachable
+		if name == n {
+			delete(b.uids, uid)
+		}
+	}
+	for k := range b.settings { // nothing to replay without the connector
+		if k.connector == n {
+			delete(b.settings, k)
+		}
+	}
 	return nil
 }
 
+// Connectors returns the sorted names of all attached connectors.
+func (b *Bricker) Connectors() []string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	names := make([]string, 0, len(b.connection))
+	for n := range b.connection {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterUid stores the connector name for a device uid.
+// Subscriber with the uid as destination are send over this connector.
+func (b *Bricker) RegisterUid(uid uint32, n string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.connection[n]; !ok {
+		return NewError(ErrorConnectorNameNotExists)
+	}
+	b.uids[uid] = n
+	return nil
+}
+
+// UnregisterUid removes the connector name for a device uid.
+func (b *Bricker) UnregisterUid(uid uint32) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.uids, uid)
+}
+
+// ConnectorOf returns the connector name for a device uid.
+// The result is false, if the uid is not registered.
+func (b *Bricker) ConnectorOf(uid uint32) (string, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	n, ok := b.uids[uid]
+	return n, ok
+}
+
 // Internal method: computeConnectorsName try to compute the connectors name from the given parameter.
 // The parameter could be a string with the name, a uid of a device (uint32) or nil, then the
 // first registered connector will be used.
+// The caller must hold the lock.
 func (b *Bricker) computeConnectorsName(d interface{}) string {
 	switch value := d.(type) {
 	case string:
@@ -0,0 +1,45 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package connector
+
+import (
+	"fmt"
+	"time"
+)
+
+// Backoff describes the waiting time between the tries of a connector to reconnect.
+// The first try waits the Initial time, every next try waits Factor times longer,
+// but never longer than the Max time.
+type Backoff struct {
+	Initial time.Duration // waiting time before the first try
+	Max     time.Duration // maximal waiting time
+	Factor  float64       // increase of the waiting time per try
+}
+
+// NewBackoff creates the default backoff.
+// It starts with 100ms, doubles the waiting time per try and waits maximal 30s.
+func NewBackoff() *Backoff {
+	return &Backoff{
+		Initial: 100 * time.Millisecond,
+		Max:     30 * time.Second,
+		Factor:  2.0}
+}
+
+// Delay computes the waiting time before the given try (starting with 0).
+func (b *Backoff) Delay(try int) time.Duration {
+	d := float64(b.Initial)
+	for i := 0; i < try && d < float64(b.Max); i++ {
+		d *= b.Factor
+	}
+	if d > float64(b.Max) {
+		return b.Max
+	}
+	return time.Duration(d)
+}
+
+// String fullfill the stringer interface.
+func (b *Backoff) String() string {
+	return fmt.Sprintf("Backoff [Initial: %s, Max: %s, Factor: %.2f]", b.Initial, b.Max, b.Factor)
+}
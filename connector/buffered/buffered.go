@@ -11,6 +11,8 @@ import (
 	"github.com/dirkjabl/bricker/event"
 	"github.com/dirkjabl/bricker/net"
 	"github.com/dirkjabl/bricker/net/packet"
+	"sync"
+	"time"
 )
 
 // ConnectorBuffered is the connector with to bufferd channels,
@@ -19,26 +21,57 @@ import (
 // The connector puts all of his readed packets into events in the In channel.
 // The connector waits for packets to write out to the hardware on the Out channel.
 // A close on the Quit channel let the bricker stops all go routines and disconnect to the hardware.
+//
+// A reconnecting connector (with a backoff) redials the hardware, if the connection is lost.
+// It puts state events (connected, disconnected, reconnected) into the In channel.
+// Without a backoff the connector stops working, if the connection is lost.
 type ConnectorBuffered struct {
-	conn *net.Net            // internal, the real connection
-	seq  *connector.Sequence // internal, actual sequence number
-	In   chan *event.Event   // input channel, here the bricker put in the readed packets as events
-	Out  chan *event.Event   // output channel, here the bricker read out the events, which should be send
-	Quit chan struct{}       // quit channel, if closed, the bricker stop working and release resources
+	conn    *net.Net            // internal, the real connection
+	lock    *sync.RWMutex       // internal, lock for the connection while redialing
+	seq     *connector.Sequence // internal, actual sequence number
+	backoff *connector.Backoff  // internal, waiting times for reconnecting, nil means no reconnect
+	stop    *sync.Once          // internal, the connector is only done once
+	In      chan *event.Event   // input channel, here the bricker put in the readed packets as events
+	Out     chan *event.Event   // output channel, here the bricker read out the events, which should be send
+	Quit    chan struct{}       // quit channel, if closed, the bricker stop working and release resources
 }
 
 // New creates the connector object with a connection to the given address (addr).
 // The function takes to integers for the size of the input and output buffer (channels).
 func New(addr string, inbuf, outbuf int) (*ConnectorBuffered, error) {
-	conn, err := net.Dial(addr)
+	return NewReconnecting(addr, inbuf, outbuf, nil)
+}
+
+// NewBrickerUnbuffered creates a connector without bufferd channels.
+// It is a buffered bricker with zero buffers.
+func NewUnbuffered(addr string) (*ConnectorBuffered, error) {
+	return New(addr, 0, 0)
+}
+
+// NewReconnecting creates a connector, which redials the connection after a lost.
+// The waiting time between the tries is computed by the backoff.
+// If the backoff is nil, the connector does not reconnect.
+// The first event of a reconnecting connector is the connected state event.
+func NewReconnecting(addr string, inbuf, outbuf int, backoff *connector.Backoff) (*ConnectorBuffered, error) {
+	return NewAuthenticated(addr, "", inbuf, outbuf, backoff)
+}
+
+// NewAuthenticated creates a connector, which authenticates the connection with the secret.
+// A wrong secret results in an error. After a reconnect the connection is authenticated again.
+// A empty secret means no authentication, a nil backoff means no reconnect.
+func NewAuthenticated(addr, secret string, inbuf, outbuf int, backoff *connector.Backoff) (*ConnectorBuffered, error) {
+	conn, err := net.DialAuthenticated(addr, secret)
 	if err != nil {
 		return nil, err
 	}
 	cb := &ConnectorBuffered{conn: conn,
-		seq:  new(connector.Sequence),
-		In:   make(chan *event.Event, inbuf),
-		Out:  make(chan *event.Event, outbuf),
-		Quit: make(chan struct{})}
+		lock:    new(sync.RWMutex),
+		seq:     new(connector.Sequence),
+		backoff: backoff,
+		stop:    new(sync.Once),
+		In:      make(chan *event.Event, inbuf),
+		Out:     make(chan *event.Event, outbuf),
+		Quit:    make(chan struct{})}
 
 	go func() { cb.read() }()
 	go func() { cb.write() }()
@@ -46,15 +79,12 @@ func New(addr string, inbuf, outbuf int) (*ConnectorBuffered, error) {
 	return cb, nil
 }
 
-// NewBrickerUnbuffered creates a connector without bufferd channels.
-// It is a buffered bricker with zero buffers.
-func NewUnbuffered(addr string) (*ConnectorBuffered, error) {
-	return New(addr, 0, 0)
-}
-
 // Send puts the given event into the channel for writing the packets to the hardware.
 func (cb *ConnectorBuffered) Send(ev *event.Event) {
-	cb.Out <- ev
+	select {
+	case cb.Out <- ev:
+	case <-cb.Quit: // done, no more sending
+	}
 }
 
 // Receive reads a event out of the channel for the readed packets form the hardware.
@@ -69,45 +99,102 @@ func (cb *ConnectorBuffered) Receive() *event.Event {
 
 // Done stops the bricker and release all connections
 func (cb *ConnectorBuffered) Done() {
-	close(cb.Quit)
-	cb.conn.Close()
+	cb.stop.Do(func() {
+		close(cb.Quit)
+		cb.lock.RLock()
+		defer cb.lock.RUnlock()
+		cb.conn.Close()
+	})
 }
 
 // read is a internal method. Method reads from the hardware connection and put the packet into the event.
+// A packet with an error code from the brick daemon is a normal event, a broken connection ends
+// the reading or starts the reconnect.
 func (cb *ConnectorBuffered) read() {
 	var err error
 	var pck *packet.Packet
-	done := false
 	defer close(cb.In)
+	if cb.backoff != nil && !cb.put(event.NewState(event.StateConnected, nil)) {
+		return
+	}
 	for {
-		if done {
+		pck, err = cb.conn.ReadPacket()
+		if err != nil && pck == nil { // connection is broken
+			if cb.done() {
+				return
+			}
+			if cb.backoff == nil {
+				cb.put(event.NewError(err))
+				return
+			}
+			if !cb.put(event.NewState(event.StateDisconnected, err)) || !cb.reconnect() {
+				return
+			}
+			continue
+		}
+		if !cb.put(event.NewSimple(err, pck)) {
 			return
 		}
-		pck, err = cb.conn.ReadPacket()
-		go func(e error, p *packet.Packet) {
-			if !done {
-				ev := event.NewSimple(e, p)
-				select {
-				case cb.In <- ev:
-				case <-cb.Quit:
-					done = true
-				}
+	}
+}
+
+// reconnect is a internal method. It redials the connection until it is established or the connector is done.
+// The result is false, if the connector is done.
+func (cb *ConnectorBuffered) reconnect() bool {
+	for try := 0; ; try++ {
+		select {
+		case <-time.After(cb.backoff.Delay(try)):
+		case <-cb.Quit:
+			return false
+		}
+		cb.lock.Lock()
+		err := cb.conn.Redial()
+		cb.lock.Unlock()
+		if err == nil {
+			if cb.done() { // done while dialing
+				cb.conn.Close()
+				return false
 			}
-		}(err, pck)
+			return cb.put(event.NewState(event.StateReconnected, nil))
+		}
+	}
+}
+
+// put is a internal method. It puts the event into the input channel.
+// The result is false, if the connector is done.
+func (cb *ConnectorBuffered) put(ev *event.Event) bool {
+	select {
+	case cb.In <- ev:
+		return true
+	case <-cb.Quit:
+		return false
+	}
+}
+
+// done is a internal method. It checks, if the connector is done.
+func (cb *ConnectorBuffered) done() bool {
+	select {
+	case <-cb.Quit:
+		return true
+	default:
+		return false
 	}
 }
 
 // write is a internal method. Method writes packets to the hardware connection.
 func (cb *ConnectorBuffered) write() {
-	defer close(cb.Out)
 	var ev *event.Event
 	for {
 		select {
 		case ev = <-cb.Out:
 			if ev != nil && ev.Err == nil && ev.Packet != nil {
-				ev.Packet.Head.SetSequence(cb.seq.GetSequence())
+				if ev.Packet.Head.Sequence() == 0 {
+					ev.Packet.Head.SetSequence(cb.seq.GetSequence())
+				}
 				ev.Packet.Head.Length = ev.Packet.ComputeLength()
+				cb.lock.RLock()
 				cb.conn.WritePacket(ev.Packet)
+				cb.lock.RUnlock()
 			}
 		case <-cb.Quit:
 			return
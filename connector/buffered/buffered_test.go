@@ -0,0 +1,90 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buffered
+
+import (
+	"github.com/dirkjabl/bricker/connector"
+	"github.com/dirkjabl/bricker/event"
+	"net"
+	"testing"
+	"time"
+)
+
+// receive reads the next event from the connector or fails after a timeout.
+func receive(t *testing.T, cb *ConnectorBuffered) *event.Event {
+	result := make(chan *event.Event, 1)
+	go func() { result <- cb.Receive() }()
+	select {
+	case e := <-result:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error receive: no event from the connector.")
+	}
+	return nil
+}
+
+func TestReconnect(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error TestReconnect: could not listen (%s).", err)
+	}
+	defer l.Close()
+	conns := make(chan net.Conn, 2)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conns <- c
+		}
+	}()
+	backoff := &connector.Backoff{Initial: 10 * time.Millisecond, Max: 100 * time.Millisecond, Factor: 2.0}
+	cb, err := NewReconnecting(l.Addr().String(), 0, 0, backoff)
+	if err != nil {
+		t.Fatalf("Error TestReconnect: could not connect (%s).", err)
+	}
+	defer cb.Done()
+	for i, state := range []uint8{event.StateConnected, event.StateDisconnected, event.StateReconnected} {
+		if state == event.StateDisconnected {
+			(<-conns).Close() // the brick daemon lost the connection
+		}
+		e := receive(t, cb)
+		if e == nil || e.State != state {
+			t.Fatalf("Error TestReconnect: event %d should be %s (%v).", i, event.StateName(state), e)
+		}
+	}
+	(<-conns).Close()
+	cb.Done()
+	for e := receive(t, cb); e != nil; e = receive(t, cb) {
+		if e.State == event.StateReconnected {
+			t.Fatal("Error TestReconnect: connector reconnects after done.")
+		}
+	}
+}
+
+func TestNoReconnect(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error TestNoReconnect: could not listen (%s).", err)
+	}
+	defer l.Close()
+	cb, err := New(l.Addr().String(), 0, 0)
+	if err != nil {
+		t.Fatalf("Error TestNoReconnect: could not connect (%s).", err)
+	}
+	defer cb.Done()
+	c, err := l.Accept()
+	if err != nil {
+		t.Fatalf("Error TestNoReconnect: could not accept (%s).", err)
+	}
+	c.Close()
+	if e := receive(t, cb); e == nil || e.Err == nil {
+		t.Fatalf("Error TestNoReconnect: lost connection should result in an error (%v).", e)
+	}
+	if e := receive(t, cb); e != nil {
+		t.Fatalf("Error TestNoReconnect: connector should stop after a lost connection (%v).", e)
+	}
+}
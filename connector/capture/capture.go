@@ -0,0 +1,178 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Capture records all events passing through a connector.
+
+The recorder is a connector, which wraps the real connector.
+Every sent and received event is written as record (timestamp, direction, connector name,
+raw packet, error and connection state) into a capture file.
+The capture file has one JSON object per line, so it is readable and could be filtered with the usual tools.
+
+	f, err := os.Create("stack.capture")
+	defer f.Close()
+	brick.Attach(capture.New(conn, "local", f), "local")
+
+A capture file could be replayed with the replay connector.
+*/
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/dirkjabl/bricker/connector"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"io"
+	"sync"
+	"time"
+)
+
+// Directions of a record.
+const (
+	DirectionIn  = "in"  // received from the hardware
+	DirectionOut = "out" // sent to the hardware
+)
+
+// Record is a captured event.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Direction string    `json:"direction"`
+	Connector string    `json:"connector"`
+	Packet    []byte    `json:"packet,omitempty"` // raw packet like on the network
+	Error     string    `json:"error,omitempty"`
+	State     uint8     `json:"state,omitempty"`
+}
+
+// NewRecord creates the record of the event.
+func NewRecord(e *event.Event, direction, connectorname string) *Record {
+	r := &Record{Time: e.TimeStamp, Direction: direction, Connector: connectorname, State: e.State}
+	if r.Time.IsZero() {
+		r.Time = time.Now()
+	}
+	if e.Err != nil {
+		r.Error = e.Err.Error()
+	}
+	if e.Packet != nil && e.Packet.Head != nil {
+		p := e.Packet.Copy()
+		p.Head.Length = p.ComputeLength()
+		buf := new(bytes.Buffer)
+		if p.Write(buf) == nil {
+			r.Packet = buf.Bytes()
+		}
+	}
+	return r
+}
+
+// Event creates the event of the record.
+// A packet with an error code of the brick daemon results in the packet and the error code.
+func (r *Record) Event() *event.Event {
+	var err error
+	var p *packet.Packet
+	if len(r.Packet) > 0 {
+		p, err = packet.ReadNew(bytes.NewReader(r.Packet))
+	}
+	if err == nil && r.Error != "" {
+		err = errors.New(r.Error)
+	}
+	e := event.New(err, r.Time, p)
+	e.ConnectorName = r.Connector
+	e.State = r.State
+	return e
+}
+
+// Writer writes records into a capture file, it could be used from many go routines.
+type Writer struct {
+	lock *sync.Mutex
+	enc  *json.Encoder
+}
+
+// NewWriter creates a writer for the capture file.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{lock: new(sync.Mutex), enc: json.NewEncoder(w)}
+}
+
+// Write appends the record to the capture file.
+func (w *Writer) Write(r *Record) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.enc.Encode(r)
+}
+
+// Reader reads the records of a capture file.
+type Reader struct {
+	dec *json.Decoder
+}
+
+// NewReader creates a reader for the capture file.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{dec: json.NewDecoder(bufio.NewReader(r))}
+}
+
+// Read returns the next record, at the end of the capture file the error is io.EOF.
+func (r *Reader) Read() (*Record, error) {
+	rec := &Record{}
+	if err := r.dec.Decode(rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ReadAll reads all records of the capture file.
+func ReadAll(r io.Reader) ([]*Record, error) {
+	reader := NewReader(r)
+	records := make([]*Record, 0)
+	for {
+		rec, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, rec)
+	}
+}
+
+// Recorder is a connector, which records all events of the wrapped connector.
+type Recorder struct {
+	conn connector.Connector
+	name string
+	w    *Writer
+}
+
+// New creates a recorder for the connector, the records get the connector name.
+// The records are written to w.
+func New(c connector.Connector, connectorname string, w io.Writer) *Recorder {
+	return NewWithWriter(c, connectorname, NewWriter(w))
+}
+
+// NewWithWriter creates a recorder with a shared writer, so more connectors could be recorded in one file.
+func NewWithWriter(c connector.Connector, connectorname string, w *Writer) *Recorder {
+	return &Recorder{conn: c, name: connectorname, w: w}
+}
+
+// Send records the event and sends it with the wrapped connector.
+func (r *Recorder) Send(e *event.Event) {
+	if e != nil {
+		r.w.Write(NewRecord(e, DirectionOut, r.name))
+	}
+	r.conn.Send(e)
+}
+
+// Receive receives the next event from the wrapped connector and records it.
+func (r *Recorder) Receive() *event.Event {
+	e := r.conn.Receive()
+	if e != nil {
+		r.w.Write(NewRecord(e, DirectionIn, r.name))
+	}
+	return e
+}
+
+// Done stops the wrapped connector.
+func (r *Recorder) Done() {
+	r.conn.Done()
+}
@@ -0,0 +1,56 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package capture
+
+import (
+	"bytes"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/errors"
+	"github.com/dirkjabl/bricker/net/packet"
+	"testing"
+)
+
+func TestRecordEvent(t *testing.T) {
+	p := packet.NewSimpleHeaderPayload(12345, 3, true, uint16(2150))
+	p.Head.SetSequence(7)
+	p.Head.ErrorCodeAndFutureUse = errors.ErrorINVALIDPARAMETER << 6
+	r := NewRecord(event.NewPacket(p), DirectionIn, "local")
+	e := r.Event()
+	if e.ConnectorName != "local" || e.Packet == nil || e.Packet.String() != p.String() {
+		t.Fatalf("Error TestRecordEvent: wrong event from record (%s).", e)
+	}
+	if err, ok := e.Err.(*errors.Error); !ok || err.Type != errors.ErrorINVALIDPARAMETER {
+		t.Fatalf("Error TestRecordEvent: error code of the packet is lost (%v).", e.Err)
+	}
+	s := NewRecord(event.NewState(event.StateDisconnected, nil), DirectionIn, "local").Event()
+	if s.State != event.StateDisconnected || s.Packet != nil {
+		t.Fatalf("Error TestRecordEvent: wrong state event from record (%s).", s)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	v := virtual.New()
+	v.AttachFallbackGenerator(func(e *event.Event) *event.Event {
+		return event.NewPacket(e.Packet.Copy())
+	})
+	buf := new(bytes.Buffer)
+	r := New(v, "virtual", buf)
+	p := packet.NewSimpleHeaderPayload(1, 2, true, uint8(3))
+	p.Head.SetSequence(4)
+	r.Send(event.NewPacket(p))
+	r.Receive()
+	r.Done()
+	records, err := ReadAll(buf)
+	if err != nil || len(records) != 2 {
+		t.Fatalf("Error TestRecorder: wrong records (%d, %v).", len(records), err)
+	}
+	for i, d := range []string{DirectionOut, DirectionIn} {
+		e := records[i].Event()
+		if records[i].Direction != d || records[i].Connector != "virtual" || e.Packet.Head.Sequence() != 4 {
+			t.Fatalf("Error TestRecorder: wrong record %d (%v).", i, records[i])
+		}
+	}
+}
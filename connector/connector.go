@@ -15,12 +15,14 @@ package connector
 import (
 	"fmt"
 	"github.com/dirkjabl/bricker/event"
+	"sync"
 )
 
 // Interface to the connector. It should send and receive events to or from the hardware.
 // The connector works with the packets inside. The connector must be thread safe implementated.
 // If no more packets can get, the receive method has to result a nil event.
 // By sending a event, the connector has to fix the packet header length.
+// If the packet has no sequence (0), the connector has to set one, a given sequence must not be changed.
 type Connector interface {
 	Send(*event.Event)
 	Receive() *event.Event
@@ -30,12 +32,16 @@ type Connector interface {
 // Sequence is a type for sequence in the header.
 // It has to be between 1 and 15 and every connector should use it.
 // It increase the sequence automaticly at call.
+// The sequence is safe for the use of many go routines.
 type Sequence struct {
+	lock  sync.Mutex
 	value uint8
 }
 
 // GetSequence give back the new sequence number.
 func (s *Sequence) GetSequence() uint8 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	s.value++
 	if s.value > 15 {
 		s.value = 1
@@ -45,5 +51,7 @@ func (s *Sequence) GetSequence() uint8 {
 
 // String to fullfill Stringer interface
 func (s *Sequence) String() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	return fmt.Sprintf("Sequence: [%d]", s.value)
 }
@@ -0,0 +1,158 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Replay is a connector, which replays a capture file.
+
+The received events of the capture are replayed in the original timing or accelerated by a speed factor.
+The sent events of the capture are the expected requests. If the bricker sends a request
+with the same uid and function id, the recorded response gets the sequence of the new request,
+so the response reaches the waiting subscriber.
+
+	f, err := os.Open("stack.capture")
+	defer f.Close()
+	conn, err := replay.New(f, "local", 10.0) // ten times faster
+	brick.Attach(conn, "local")
+*/
+package replay
+
+import (
+	"github.com/dirkjabl/bricker/connector/capture"
+	"github.com/dirkjabl/bricker/event"
+	"io"
+	"sync"
+	"time"
+)
+
+// Replay is the connector for replaying a capture.
+type Replay struct {
+	lock     *sync.Mutex
+	stop     *sync.Once
+	in       []*capture.Record
+	out      []*capture.Record
+	speed    float64
+	first    time.Time
+	start    time.Time
+	sequence map[request]uint8
+	quit     chan struct{}
+}
+
+// Internal type: request identifies a recorded request by uid, function id and recorded sequence.
+type request struct {
+	uid      uint32
+	fid      uint8
+	sequence uint8
+}
+
+// New creates a replay connector for the records of the connector with the name in the capture.
+// A empty name replays the records of all connectors.
+// The speed accelerates the replay, 1.0 means the original timing, 0 means without waiting.
+func New(r io.Reader, connectorname string, speed float64) (*Replay, error) {
+	records, err := capture.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewRecords(records, connectorname, speed), nil
+}
+
+// NewRecords creates a replay connector for the given records.
+func NewRecords(records []*capture.Record, connectorname string, speed float64) *Replay {
+	rp := &Replay{
+		lock:     new(sync.Mutex),
+		stop:     new(sync.Once),
+		in:       make([]*capture.Record, 0),
+		out:      make([]*capture.Record, 0),
+		speed:    speed,
+		sequence: make(map[request]uint8),
+		quit:     make(chan struct{})}
+	for _, r := range records {
+		if connectorname != "" && r.Connector != connectorname {
+			continue
+		}
+		if r.Direction == capture.DirectionOut {
+			rp.out = append(rp.out, r)
+		} else {
+			rp.in = append(rp.in, r)
+		}
+	}
+	if len(rp.in) > 0 {
+		rp.first = rp.in[0].Time
+	}
+	return rp
+}
+
+// Send takes a request and looks for the same recorded request.
+// The recorded response of this request will get the sequence of the given request.
+func (rp *Replay) Send(e *event.Event) {
+	if e == nil || e.Packet == nil || e.Packet.Head == nil {
+		return
+	}
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	h := e.Packet.Head
+	for i, r := range rp.out {
+		o := r.Event()
+		if o.Packet != nil && o.Packet.Head.Uid == h.Uid && o.Packet.Head.FunctionID == h.FunctionID {
+			rp.sequence[request{uid: h.Uid, fid: h.FunctionID, sequence: o.Packet.Head.Sequence()}] = h.Sequence()
+			rp.out = append(rp.out[:i], rp.out[i+1:]...)
+			return
+		}
+	}
+}
+
+// Receive returns the next recorded event at his time.
+// At the end of the capture, the result is nil.
+func (rp *Replay) Receive() *event.Event {
+	rp.lock.Lock()
+	if len(rp.in) == 0 {
+		rp.lock.Unlock()
+		rp.Done()
+		return nil
+	}
+	r := rp.in[0]
+	rp.in = rp.in[1:]
+	if rp.start.IsZero() { // replay starts with the first event
+		rp.start = time.Now()
+	}
+	wait := rp.start.Add(rp.offset(r)).Sub(time.Now())
+	rp.lock.Unlock()
+	if wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-rp.quit:
+			return nil
+		}
+	}
+	e := r.Event()
+	e.ConnectorName = ""
+	e.TimeStamp = time.Now()
+	if e.Packet != nil && e.Packet.Head.Sequence() != 0 {
+		rp.lock.Lock()
+		k := request{uid: e.Packet.Head.Uid, fid: e.Packet.Head.FunctionID, sequence: e.Packet.Head.Sequence()}
+		if s, ok := rp.sequence[k]; ok && s != 0 {
+			e.Packet.Head.SetSequence(s)
+			delete(rp.sequence, k)
+		}
+		rp.lock.Unlock()
+	}
+	return e
+}
+
+// Done stops the replay, the next Receive returns nil.
+func (rp *Replay) Done() {
+	rp.stop.Do(func() {
+		close(rp.quit)
+		rp.lock.Lock()
+		defer rp.lock.Unlock()
+		rp.in = rp.in[:0]
+	})
+}
+
+// Internal method: offset computes the replay time of the record relative to the first record.
+func (rp *Replay) offset(r *capture.Record) time.Duration {
+	if rp.speed <= 0 {
+		return 0
+	}
+	return time.Duration(float64(r.Time.Sub(rp.first)) / rp.speed)
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package replay
+
+import (
+	"github.com/dirkjabl/bricker/connector/capture"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"testing"
+	"time"
+)
+
+// record creates a record of a packet with the sequence at the time.
+func record(direction string, fid, sequence uint8, at time.Time) *capture.Record {
+	p := packet.NewSimpleHeaderOnly(1, fid, direction == capture.DirectionOut)
+	if sequence != 0 {
+		p.Head.SetSequence(sequence)
+	}
+	return capture.NewRecord(event.New(nil, at, p), direction, "local")
+}
+
+func TestReplay(t *testing.T) {
+	now := time.Now()
+	records := []*capture.Record{
+		record(capture.DirectionOut, 1, 5, now),
+		record(capture.DirectionIn, 1, 5, now.Add(100*time.Millisecond)),
+		record(capture.DirectionIn, 8, 0, now.Add(200*time.Millisecond))}
+	records = append(records, capture.NewRecord(event.NewPacket(nil), capture.DirectionIn, "other"))
+	rp := NewRecords(records, "local", 10.0)
+	defer rp.Done()
+	p := packet.NewSimpleHeaderOnly(1, 1, true)
+	p.Head.SetSequence(2)
+	rp.Send(event.NewPacket(p))
+	start := time.Now()
+	e := rp.Receive()
+	if e == nil || e.Packet.Head.FunctionID != 1 || e.Packet.Head.Sequence() != 2 {
+		t.Fatalf("Error TestReplay: response should get the sequence of the request (%s).", e)
+	}
+	e = rp.Receive()
+	if e == nil || e.Packet.Head.FunctionID != 8 || e.Packet.Head.Sequence() != 0 {
+		t.Fatalf("Error TestReplay: wrong callback (%s).", e)
+	}
+	if d := time.Since(start); d < 8*time.Millisecond || d > time.Second {
+		t.Fatalf("Error TestReplay: wrong replay timing (%s).", d)
+	}
+	if e = rp.Receive(); e != nil {
+		t.Fatalf("Error TestReplay: records of other connectors replayed (%s).", e)
+	}
+}
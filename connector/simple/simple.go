@@ -22,7 +22,13 @@ type ConnectorSimple struct {
 
 // New creates a simple connector with read and write locks.
 func New(addr string) (*ConnectorSimple, error) {
-	conn, err := net.Dial(addr)
+	return NewAuthenticated(addr, "")
+}
+
+// NewAuthenticated creates a simple connector, which authenticates the connection with the secret.
+// A wrong secret results in an error.
+func NewAuthenticated(addr, secret string) (*ConnectorSimple, error) {
+	conn, err := net.DialAuthenticated(addr, secret)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +47,9 @@ func (cs *ConnectorSimple) Send(ev *event.Event) {
 	}
 	cs.wlock.Lock()
 	defer cs.wlock.Unlock()
-	ev.Packet.Head.SetSequence(cs.seq.GetSequence())
+	if ev.Packet.Head.Sequence() == 0 {
+		ev.Packet.Head.SetSequence(cs.seq.GetSequence())
+	}
 	ev.Packet.Head.Length = ev.Packet.ComputeLength()
 	cs.conn.WritePacket(ev.Packet)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/dirkjabl/bricker/connector"
 	"github.com/dirkjabl/bricker/event"
 	"github.com/dirkjabl/bricker/util/hash"
+	"sync"
 )
 
 // This is the generator type and it is a function.
@@ -23,8 +24,13 @@ type GeneratorFunc func(*event.Event) *event.Event
 // packet comes in (per Send()).
 // The matching works with hashes (hash.Hash).
 // A fallback generator exists and could overwritten.
+// The virtual connector is thread safe, generators could be called from many go routines.
 type Virtual struct {
+	lock      *sync.RWMutex
+	stop      *sync.Once
 	receive   chan *event.Event
+	quit      chan struct{}
+	done      bool
 	generator map[hash.Hash]GeneratorFunc
 	fallback  GeneratorFunc
 	serial    *connector.Sequence
@@ -33,7 +39,10 @@ type Virtual struct {
 // New creates a new virtual connector.
 func New() *Virtual {
 	v := &Virtual{
+		lock:      new(sync.RWMutex),
+		stop:      new(sync.Once),
 		receive:   make(chan *event.Event, 20),
+		quit:      make(chan struct{}),
 		serial:    new(connector.Sequence),
 		generator: make(map[hash.Hash]GeneratorFunc)}
 	v.DetachFallbackGenerator()
@@ -43,17 +52,23 @@ func New() *Virtual {
 // AttachGenerator add a new generator to the connector.
 // If a generator exists with the same hash, it will be overwritten.
 func (v *Virtual) AttachGenerator(h hash.Hash, f GeneratorFunc) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
 	v.generator[h] = f
 }
 
 // AttachFallbackGenerator change the existing fallback generator to
 // the new given.
 func (v *Virtual) AttachFallbackGenerator(f GeneratorFunc) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
 	v.fallback = f
 }
 
 // DetachGenerator removes a generator.
 func (v *Virtual) DetachGenerator(h hash.Hash) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
 	delete(v.generator, h)
 }
 
@@ -65,18 +80,44 @@ func (v *Virtual) DetachFallbackGenerator() {
 
 // Send takes the given event and looks for a generator for it.
 // If the generator returns a event, it will be put in the receive channel.
+// Like the brick daemon, a response (same uid and function id) without a sequence
+// gets the sequence of the request.
 func (v *Virtual) Send(e *event.Event) {
 	if e == nil { // no event, no processing
 		return
 	}
 	if e.Packet != nil {
-		e.Packet.Head.SetSequence(v.serial.GetSequence())
+		if e.Packet.Head.Sequence() == 0 {
+			e.Packet.Head.SetSequence(v.serial.GetSequence())
+		}
 		e.Packet.Head.Length = e.Packet.ComputeLength()
 	}
+	v.lock.RLock()
 	f := v.getGen(e)
-	r := f(e)
+	v.lock.RUnlock()
+	r := f(e) // generator runs without lock, so it could attach or detach generators
 	if r != nil {
-		v.receive <- r
+		if isResponse(e, r) && r.Packet.Head.Sequence() == 0 {
+			r.Packet.Head.SetSequence(e.Packet.Head.Sequence())
+		}
+		v.Emit(r)
+	}
+}
+
+// Emit puts the given event direct into the receive channel, without a generator.
+// With Emit a test could simulate callbacks or state events (like a lost connection).
+func (v *Virtual) Emit(e *event.Event) {
+	if e == nil {
+		return
+	}
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if v.done { // closed receive channel, no result
+		return
+	}
+	select {
+	case v.receive <- e:
+	case <-v.quit:
 	}
 }
 
@@ -94,11 +135,15 @@ func (v *Virtual) Receive() *event.Event {
 // Close the receive channel.
 // The virtual connector should not longer used.
 func (v *Virtual) Done() {
-	for h, _ := range v.generator {
-		v.DetachGenerator(h)
-	}
-	v.DetachFallbackGenerator()
-	close(v.receive)
+	v.stop.Do(func() {
+		close(v.quit) // release waiting senders
+		v.lock.Lock()
+		defer v.lock.Unlock()
+		v.generator = make(map[hash.Hash]GeneratorFunc)
+		v.fallback = Fallback
+		v.done = true
+		close(v.receive)
+	})
 }
 
 // Fallback is the basic fallback generator.
@@ -108,13 +153,23 @@ func Fallback(e *event.Event) *event.Event {
 }
 
 // Internal method: getGen find a generator to run with this event.
+// The caller must hold the lock.
 func (v *Virtual) getGen(e *event.Event) GeneratorFunc {
 	var h hash.Hash
+	if e.Packet == nil || e.Packet.Head == nil { // no packet, no matching
+		return v.fallback
+	}
 	for _, c := range hash.All() {
 		h = hash.New(c, e.Packet.Head.Uid, e.Packet.Head.FunctionID)
 		if f, ok := v.generator[h]; ok {
 			return f
 		}
 	}
-	return Fallback
+	return v.fallback
+}
+
+// Internal method: isResponse checks, if the result event is a response to the event.
+func isResponse(e, r *event.Event) bool {
+	return e.Packet != nil && e.Packet.Head != nil && r.Packet != nil && r.Packet.Head != nil &&
+		e.Packet.Head.Uid == r.Packet.Head.Uid && e.Packet.Head.FunctionID == r.Packet.Head.FunctionID
 }
@@ -0,0 +1,61 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetCurrentConsumption creates the subscriber to get the current consumption (mA) of the motor.
+func GetCurrentConsumption(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentConsumption"),
+		Fid:        function_get_current_consumption,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentConsumptionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentConsumptionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetCurrentConsumption("getcurrentconsumptionfuture"+device.GenId(), uid, nil))
+}
+
+// Current is a current in mA.
+type Current struct {
+	Value uint16 // mA
+}
+
+// FromPacket creates from a packet a Current.
+func (c *Current) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Current) String() string {
+	txt := "Current "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mA]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Current) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Current{Value: c.Value}
+}
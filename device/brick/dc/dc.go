@@ -0,0 +1,50 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the DC Brick.
+package dc
+
+const (
+	function_set_velocity                = uint8(1)
+	function_get_velocity                = uint8(2)
+	function_get_current_velocity        = uint8(3)
+	function_set_acceleration            = uint8(4)
+	function_get_acceleration            = uint8(5)
+	function_set_pwm_frequency           = uint8(6)
+	function_get_pwm_frequency           = uint8(7)
+	function_full_brake                  = uint8(8)
+	function_get_stack_input_voltage     = uint8(9)
+	function_get_external_input_voltage  = uint8(10)
+	function_get_current_consumption     = uint8(11)
+	function_enable                      = uint8(12)
+	function_disable                     = uint8(13)
+	function_is_enabled                  = uint8(14)
+	function_set_minimum_voltage         = uint8(15)
+	function_get_minimum_voltage         = uint8(16)
+	function_set_drive_mode              = uint8(17)
+	function_get_drive_mode              = uint8(18)
+	function_set_current_velocity_period = uint8(19)
+	function_get_current_velocity_period = uint8(20)
+	function_get_chip_temperature        = uint8(242)
+	function_reset                       = uint8(243)
+	callback_under_voltage               = uint8(21)
+	callback_emergency_shutdown          = uint8(22)
+	callback_velocity_reached            = uint8(23)
+	callback_current_velocity            = uint8(24)
+	// Drive modes
+	DriveModeDriveBrake = uint8(0)
+	DriveModeDriveCoast = uint8(1)
+)
+
+// DriveModeName results a string representation of the given drive mode.
+func DriveModeName(m uint8) string {
+	switch m {
+	case DriveModeDriveBrake:
+		return "Drive/Brake"
+	case DriveModeDriveCoast:
+		return "Drive/Coast"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"bytes"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/util/fakedevice"
+	"testing"
+)
+
+const uid = uint32(4711)
+
+// newDC creates a fake dc brick, the getter of all setters has the next function id.
+func newDC(t *testing.T) (*bricker.Bricker, *fakedevice.Device) {
+	f := fakedevice.New(t, uid)
+	for _, fid := range []uint8{function_set_velocity, function_set_acceleration, function_set_pwm_frequency,
+		function_set_minimum_voltage, function_set_drive_mode, function_set_current_velocity_period} {
+		f.Store(fid, fid+1)
+	}
+	return f.Bricker(), f
+}
+
+func TestSetGet(t *testing.T) {
+	b, f := newDC(t)
+	defer f.Done()
+	if err := SetVelocityFuture(b, "virtual", uid, &Velocity{Value: -16384}); err != nil {
+		t.Fatalf("Error TestSetGet: SetVelocity failed (%s).", err)
+	}
+	if r := f.Request(function_set_velocity); !bytes.Equal(r, []byte{0x00, 0xc0}) {
+		t.Fatalf("Error TestSetGet: wrong velocity payload % x.", r)
+	}
+	if r, err := GetVelocityFuture(b, "virtual", uid); err != nil || r.Value != -16384 {
+		t.Fatalf("Error TestSetGet: wrong velocity (%v, %s).", r, err)
+	}
+	if err := SetAccelerationFuture(b, "virtual", uid, &Acceleration{Value: 5000}); err != nil {
+		t.Fatalf("Error TestSetGet: SetAcceleration failed (%s).", err)
+	}
+	if r, err := GetAccelerationFuture(b, "virtual", uid); err != nil || r.Value != 5000 {
+		t.Fatalf("Error TestSetGet: wrong acceleration (%v, %s).", r, err)
+	}
+	if err := SetPWMFrequencyFuture(b, "virtual", uid, &PWMFrequency{Value: 15000}); err != nil {
+		t.Fatalf("Error TestSetGet: SetPWMFrequency failed (%s).", err)
+	}
+	if r, err := GetPWMFrequencyFuture(b, "virtual", uid); err != nil || r.Value != 15000 {
+		t.Fatalf("Error TestSetGet: wrong pwm frequency (%v, %s).", r, err)
+	}
+	if err := SetMinimumVoltageFuture(b, "virtual", uid, &Voltage{Value: 6000}); err != nil {
+		t.Fatalf("Error TestSetGet: SetMinimumVoltage failed (%s).", err)
+	}
+	if r, err := GetMinimumVoltageFuture(b, "virtual", uid); err != nil || r.Value != 6000 {
+		t.Fatalf("Error TestSetGet: wrong minimum voltage (%v, %s).", r, err)
+	}
+	if err := SetDriveModeFuture(b, "virtual", uid, &DriveMode{Value: DriveModeDriveCoast}); err != nil {
+		t.Fatalf("Error TestSetGet: SetDriveMode failed (%s).", err)
+	}
+	if r, err := GetDriveModeFuture(b, "virtual", uid); err != nil || r.Value != DriveModeDriveCoast ||
+		r.Name() != DriveModeName(DriveModeDriveCoast) {
+		t.Fatalf("Error TestSetGet: wrong drive mode (%v, %s).", r, err)
+	}
+	if err := SetCurrentVelocityPeriodFuture(b, "virtual", uid, &Period{Value: 100}); err != nil {
+		t.Fatalf("Error TestSetGet: SetCurrentVelocityPeriod failed (%s).", err)
+	}
+	if r, err := GetCurrentVelocityPeriodFuture(b, "virtual", uid); err != nil || r.Value != 100 {
+		t.Fatalf("Error TestSetGet: wrong current velocity period (%v, %s).", r, err)
+	}
+}
+
+func TestGetter(t *testing.T) {
+	b, f := newDC(t)
+	defer f.Done()
+	f.Respond(function_get_current_velocity, &Velocity{Value: 1200})
+	f.Respond(function_get_stack_input_voltage, &Voltage{Value: 12100})
+	f.Respond(function_get_external_input_voltage, &Voltage{Value: 7400})
+	f.Respond(function_get_current_consumption, &Current{Value: 950})
+	f.Respond(function_is_enabled, &EnabledRaw{Value: 1})
+	if r, err := GetCurrentVelocityFuture(b, "virtual", uid); err != nil || r.Value != 1200 {
+		t.Fatalf("Error TestGetter: wrong current velocity (%v, %s).", r, err)
+	}
+	if r, err := GetStackInputVoltageFuture(b, "virtual", uid); err != nil || r.Value != 12100 {
+		t.Fatalf("Error TestGetter: wrong stack input voltage (%v, %s).", r, err)
+	}
+	if r, err := GetExternalInputVoltageFuture(b, "virtual", uid); err != nil || r.Value != 7400 {
+		t.Fatalf("Error TestGetter: wrong external input voltage (%v, %s).", r, err)
+	}
+	if r, err := GetCurrentConsumptionFuture(b, "virtual", uid); err != nil || r.Value != 950 {
+		t.Fatalf("Error TestGetter: wrong current consumption (%v, %s).", r, err)
+	}
+	if r, err := IsEnabledFutureSimple(b, "virtual", uid); err != nil || !r {
+		t.Fatalf("Error TestGetter: should be enabled (%t, %s).", r, err)
+	}
+}
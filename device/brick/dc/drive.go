@@ -0,0 +1,298 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// FullBrake creates the subscriber to stop the motor immediately.
+// The velocity is set to 0 without acceleration, use this only for emergencies.
+// Call SetVelocity with 0 for a normal stop.
+func FullBrake(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "FullBrake"),
+		Fid:        function_full_brake,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// FullBrakeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func FullBrakeFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, FullBrake("fullbrakefuture"+device.GenId(), uid, nil))
+}
+
+// Enable creates the subscriber to enable the driver of the motor.
+// The configuration (velocity, acceleration, ...) could be done before enabling.
+func Enable(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Enable"),
+		Fid:        function_enable,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnableFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Enable("enablefuture"+device.GenId(), uid, nil))
+}
+
+// Disable creates the subscriber to disable the driver of the motor.
+// The velocity should be 0 before, otherwise the motor coasts out.
+func Disable(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Disable"),
+		Fid:        function_disable,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisableFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Disable("disablefuture"+device.GenId(), uid, nil))
+}
+
+// IsEnabled creates the subscriber to get the enabled state of the driver.
+func IsEnabled(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsEnabled"),
+		Fid:        function_is_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsEnabled("isenabledfuture"+device.GenId(), uid, nil))
+}
+
+// IsEnabledFutureSimple calls the IsEnabledFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsEnabledFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	e, err := IsEnabledFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return e.Value, nil
+}
+
+// SetDriveMode creates the subscriber to set the drive mode.
+// Drive/Brake (0) gives a linear correlation between PWM and velocity and a more exact regulation.
+// Drive/Coast (1) uses less current, but the velocity is less linear.
+func SetDriveMode(id string, uid uint32, dm *DriveMode, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDriveMode"),
+		Fid:        function_set_drive_mode,
+		Uid:        uid,
+		Data:       dm,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDriveModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDriveModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, dm *DriveMode) error {
+	return device.FutureEmpty(brick, connectorname, SetDriveMode("setdrivemodefuture"+device.GenId(), uid, dm, nil))
+}
+
+// GetDriveMode creates the subscriber to get the drive mode, which was set with SetDriveMode.
+func GetDriveMode(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDriveMode"),
+		Fid:        function_get_drive_mode,
+		Uid:        uid,
+		Result:     &DriveMode{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDriveModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDriveModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*DriveMode, error) {
+	return device.FutureOf[*DriveMode](brick, connectorname, GetDriveMode("getdrivemodefuture"+device.GenId(), uid, nil))
+}
+
+// SetPWMFrequency creates the subscriber to set the frequency (Hz) of the PWM, which controls the velocity.
+// The frequency is between 1Hz and 20000Hz, the default is 15000Hz.
+func SetPWMFrequency(id string, uid uint32, f *PWMFrequency, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPWMFrequency"),
+		Fid:        function_set_pwm_frequency,
+		Uid:        uid,
+		Data:       f,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPWMFrequencyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPWMFrequencyFuture(brick *bricker.Bricker, connectorname string, uid uint32, f *PWMFrequency) error {
+	return device.FutureEmpty(brick, connectorname, SetPWMFrequency("setpwmfrequencyfuture"+device.GenId(), uid, f, nil))
+}
+
+// GetPWMFrequency creates the subscriber to get the PWM frequency, which was set with SetPWMFrequency.
+func GetPWMFrequency(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPWMFrequency"),
+		Fid:        function_get_pwm_frequency,
+		Uid:        uid,
+		Result:     &PWMFrequency{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPWMFrequencyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPWMFrequencyFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*PWMFrequency, error) {
+	return device.FutureOf[*PWMFrequency](brick, connectorname, GetPWMFrequency("getpwmfrequencyfuture"+device.GenId(), uid, nil))
+}
+
+// EmergencyShutdown creates the subscriber for the emergency shutdown callback.
+// The callback is triggered, if the driver is shut down because of over current or over temperature.
+// The driver must be enabled again with Enable after an emergency shutdown.
+func EmergencyShutdown(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "EmergencyShutdown"),
+		Fid:        callback_emergency_shutdown,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Enabled is a type for showing if the driver is enabled or disabled.
+type Enabled struct {
+	Value bool // true - enabled, false - disabled
+}
+
+// FromPacket converts the packet payload to the Enabled type.
+func (e *Enabled) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(EnabledRaw)
+	err := p.Payload.Decode(er)
+	if err == nil && er != nil {
+		e.FromEnabledRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (e *Enabled) String() string {
+	txt := "Enabled "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", e.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Enabled) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Enabled{Value: e.Value}
+}
+
+// FromEnabledRaw converts the EnabledRaw into a Enabled.
+func (e *Enabled) FromEnabledRaw(er *EnabledRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Value = misc.Uint8ToBool(er.Value)
+}
+
+// EnabledRaw is the real de/encoding type for a Enabled.
+type EnabledRaw struct {
+	Value uint8
+}
+
+// DriveMode is the drive mode of the motor.
+type DriveMode struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a DriveMode.
+func (dm *DriveMode) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(dm, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(dm)
+}
+
+// Name gives a readable representation of the drive mode as string.
+func (dm *DriveMode) Name() string {
+	if dm == nil {
+		return ""
+	}
+	return DriveModeName(dm.Value)
+}
+
+// String fullfill the stringer interface.
+func (dm *DriveMode) String() string {
+	txt := "Drive mode "
+	if dm == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", dm.Name(), dm.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (dm *DriveMode) Copy() device.Resulter {
+	if dm == nil {
+		return nil
+	}
+	return &DriveMode{Value: dm.Value}
+}
+
+// PWMFrequency is the frequency of the PWM in Hz.
+type PWMFrequency struct {
+	Value uint16 // Hz
+}
+
+// FromPacket creates from a packet a PWMFrequency.
+func (f *PWMFrequency) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(f, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(f)
+}
+
+// String fullfill the stringer interface.
+func (f *PWMFrequency) String() string {
+	txt := "PWM frequency "
+	if f == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d Hz]", f.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (f *PWMFrequency) Copy() device.Resulter {
+	if f == nil {
+		return nil
+	}
+	return &PWMFrequency{Value: f.Value}
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetChipTemperature creates the subscriber to get the temperature of the microcontroller.
+// The temperature is only proportional to the real temperature and has an accuracy of +-15%.
+func GetChipTemperature(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetChipTemperature"),
+		Fid:        function_get_chip_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetChipTemperatureFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetChipTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetChipTemperature("getchiptemperaturefuture"+device.GenId(), uid, nil))
+}
+
+// Reset creates the subscriber to reset the DC brick.
+// After a reset all configurations are lost and the stack must be enumerated again.
+func Reset(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Reset"),
+		Fid:        function_reset,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// Temperature type with a value °C/100.
+type Temperature struct {
+	Value int16
+}
+
+// FromPacket creates from a packet a Temperature.
+func (t *Temperature) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// String fullfill the stringer interface.
+func (t *Temperature) String() string {
+	txt := "Temperature "
+	if t == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Temperature: %02.02f °C]", t.Value, t.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (t *Temperature) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &Temperature{Value: t.Value}
+}
+
+// Float64 converts the temperature value to a float.
+func (t *Temperature) Float64() float64 {
+	return float64(t.Value) / 100.0
+}
@@ -0,0 +1,268 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetVelocity creates the subscriber to set the velocity of the motor.
+// The velocity goes from -32767 (full speed backward) over 0 (stop) to 32767 (full speed forward).
+// The motor is accelerated with the value of SetAcceleration, if the velocity is reached,
+// the VelocityReached callback is triggered.
+func SetVelocity(id string, uid uint32, v *Velocity, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetVelocity"),
+		Fid:        function_set_velocity,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Velocity) error {
+	return device.FutureEmpty(brick, connectorname, SetVelocity("setvelocityfuture"+device.GenId(), uid, v, nil))
+}
+
+// GetVelocity creates the subscriber to get the velocity, which was set with SetVelocity.
+func GetVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetVelocity"),
+		Fid:        function_get_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetVelocity("getvelocityfuture"+device.GenId(), uid, nil))
+}
+
+// GetCurrentVelocity creates the subscriber to get the current velocity of the motor.
+// The value differs from the set velocity, while the motor accelerates.
+func GetCurrentVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentVelocity"),
+		Fid:        function_get_current_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetCurrentVelocity("getcurrentvelocityfuture"+device.GenId(), uid, nil))
+}
+
+// SetAcceleration creates the subscriber to set the acceleration of the motor (velocity/s).
+// A value of 0 means no acceleration, the velocity is set immediately.
+// The default value is 10000.
+func SetAcceleration(id string, uid uint32, a *Acceleration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAcceleration"),
+		Fid:        function_set_acceleration,
+		Uid:        uid,
+		Data:       a,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAccelerationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAccelerationFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *Acceleration) error {
+	return device.FutureEmpty(brick, connectorname, SetAcceleration("setaccelerationfuture"+device.GenId(), uid, a, nil))
+}
+
+// GetAcceleration creates the subscriber to get the acceleration, which was set with SetAcceleration.
+func GetAcceleration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAcceleration"),
+		Fid:        function_get_acceleration,
+		Uid:        uid,
+		Result:     &Acceleration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAccelerationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAccelerationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Acceleration, error) {
+	return device.FutureOf[*Acceleration](brick, connectorname, GetAcceleration("getaccelerationfuture"+device.GenId(), uid, nil))
+}
+
+// SetCurrentVelocityPeriod creates the subscriber to set the period (ms) of the CurrentVelocity callback.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+func SetCurrentVelocityPeriod(id string, uid uint32, pe *Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentVelocityPeriod"),
+		Fid:        function_set_current_velocity_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentVelocityPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentVelocityPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *Period) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentVelocityPeriod("setcurrentvelocityperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetCurrentVelocityPeriod creates the subscriber to get the period of the CurrentVelocity callback.
+func GetCurrentVelocityPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentVelocityPeriod"),
+		Fid:        function_get_current_velocity_period,
+		Uid:        uid,
+		Result:     &Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentVelocityPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentVelocityPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Period, error) {
+	return device.FutureOf[*Period](brick, connectorname, GetCurrentVelocityPeriod("getcurrentvelocityperiodfuture"+device.GenId(), uid, nil))
+}
+
+// VelocityReached creates the subscriber for the velocity reached callback.
+// The callback is triggered, if a velocity set by SetVelocity is reached.
+// Without acceleration the callback is triggered directly after the call of SetVelocity.
+func VelocityReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "VelocityReached"),
+		Fid:        callback_velocity_reached,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// CurrentVelocity creates the subscriber for the periodical current velocity callback.
+// The period is set with SetCurrentVelocityPeriod, the callback is only triggered
+// if the velocity has changed since the last triggering.
+func CurrentVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CurrentVelocity"),
+		Fid:        callback_current_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Velocity is the velocity of the motor (-32767 to 32767).
+type Velocity struct {
+	Value int16
+}
+
+// FromPacket creates from a packet a Velocity.
+func (v *Velocity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Velocity) String() string {
+	txt := "Velocity "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Velocity) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Velocity{Value: v.Value}
+}
+
+// Percent converts the velocity into the percentage of the full speed (-100.0 to 100.0).
+func (v *Velocity) Percent() float64 {
+	return float64(v.Value) * 100.0 / 32767.0
+}
+
+// Acceleration is the acceleration of the motor in velocity/s.
+type Acceleration struct {
+	Value uint16 // velocity/s
+}
+
+// FromPacket creates from a packet a Acceleration.
+func (a *Acceleration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *Acceleration) String() string {
+	txt := "Acceleration "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d velocity/s]", a.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Acceleration) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Acceleration{Value: a.Value}
+}
+
+// Period is the period of the CurrentVelocity callback in ms.
+type Period struct {
+	Value uint16 // ms
+}
+
+// FromPacket creates from a packet a Period.
+func (pe *Period) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(pe, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(pe)
+}
+
+// String fullfill the stringer interface.
+func (pe *Period) String() string {
+	txt := "Period "
+	if pe == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d ms]", pe.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (pe *Period) Copy() device.Resulter {
+	if pe == nil {
+		return nil
+	}
+	return &Period{Value: pe.Value}
+}
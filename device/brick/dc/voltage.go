@@ -0,0 +1,136 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetStackInputVoltage creates the subscriber to get the input voltage (mV) of the stack.
+// The stack input voltage comes from a power supply of the stack.
+func GetStackInputVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackInputVoltage"),
+		Fid:        function_get_stack_input_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackInputVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackInputVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetStackInputVoltage("getstackinputvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// GetExternalInputVoltage creates the subscriber to get the external input voltage (mV).
+// The external input voltage comes from the black power input connector of the brick.
+// If a external voltage and a stack voltage is present, the external voltage is used for the motor.
+func GetExternalInputVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetExternalInputVoltage"),
+		Fid:        function_get_external_input_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetExternalInputVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetExternalInputVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetExternalInputVoltage("getexternalinputvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// SetMinimumVoltage creates the subscriber to set the minimal voltage (mV).
+// If the input voltage drops below this value, the UnderVoltage callback is triggered.
+// The default value is 8V (8000mV).
+func SetMinimumVoltage(id string, uid uint32, v *Voltage, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMinimumVoltage"),
+		Fid:        function_set_minimum_voltage,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMinimumVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMinimumVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Voltage) error {
+	return device.FutureEmpty(brick, connectorname, SetMinimumVoltage("setminimumvoltagefuture"+device.GenId(), uid, v, nil))
+}
+
+// GetMinimumVoltage creates the subscriber to get the minimal voltage, which was set with SetMinimumVoltage.
+func GetMinimumVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMinimumVoltage"),
+		Fid:        function_get_minimum_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMinimumVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMinimumVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetMinimumVoltage("getminimumvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// UnderVoltage creates the subscriber for the under voltage callback.
+// The callback is triggered, if the input voltage drops below the minimal voltage (see SetMinimumVoltage).
+// The result is the current input voltage.
+func UnderVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "UnderVoltage"),
+		Fid:        callback_under_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Voltage result type.
+type Voltage struct {
+	Value uint16 // mV
+}
+
+// FromPacket creates from a packet a Voltage.
+func (v *Voltage) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Voltage) String() string {
+	txt := "Voltage "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mV]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Voltage) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Voltage{Value: v.Value}
+}
+
+// Float64 converts the voltage value (mV) into V.
+func (v *Voltage) Float64() float64 {
+	return float64(v.Value) / 1000.0
+}
@@ -0,0 +1,200 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetAccelerationRange creates the subscriber to set the range of the accelerometer.
+// Currently only the range 0 (±16g) is supported.
+func SetAccelerationRange(id string, uid uint32, r *Range, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAccelerationRange"),
+		Fid:        function_set_acceleration_range,
+		Uid:        uid,
+		Data:       r,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAccelerationRangeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAccelerationRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Range) error {
+	return device.FutureEmpty(brick, connectorname, SetAccelerationRange("setaccelerationrangefuture"+device.GenId(), uid, r, nil))
+}
+
+// GetAccelerationRange creates the subscriber to get the range of the accelerometer.
+func GetAccelerationRange(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAccelerationRange"),
+		Fid:        function_get_acceleration_range,
+		Uid:        uid,
+		Result:     &Range{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAccelerationRangeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAccelerationRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Range, error) {
+	return device.FutureOf[*Range](brick, connectorname, GetAccelerationRange("getaccelerationrangefuture"+device.GenId(), uid, nil))
+}
+
+// SetMagnetometerRange creates the subscriber to set the range of the magnetometer.
+// Currently only the range 0 (±1.3 Gauss) is supported.
+func SetMagnetometerRange(id string, uid uint32, r *Range, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMagnetometerRange"),
+		Fid:        function_set_magnetometer_range,
+		Uid:        uid,
+		Data:       r,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMagnetometerRangeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMagnetometerRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Range) error {
+	return device.FutureEmpty(brick, connectorname, SetMagnetometerRange("setmagnetometerrangefuture"+device.GenId(), uid, r, nil))
+}
+
+// GetMagnetometerRange creates the subscriber to get the range of the magnetometer.
+func GetMagnetometerRange(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMagnetometerRange"),
+		Fid:        function_get_magnetometer_range,
+		Uid:        uid,
+		Result:     &Range{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMagnetometerRangeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMagnetometerRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Range, error) {
+	return device.FutureOf[*Range](brick, connectorname, GetMagnetometerRange("getmagnetometerrangefuture"+device.GenId(), uid, nil))
+}
+
+// SetCalibration creates the subscriber to set the calibration of a sensor.
+// The IMU is factory calibrated, a wrong calibration makes the values unusable.
+// Use the calibration tool of the brick viewer, if possible.
+func SetCalibration(id string, uid uint32, c *Calibration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCalibration"),
+		Fid:        function_set_calibration,
+		Uid:        uid,
+		Data:       c,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCalibrationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCalibrationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Calibration) error {
+	return device.FutureEmpty(brick, connectorname, SetCalibration("setcalibrationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetCalibration creates the subscriber to get the calibration of a sensor for the calibration type.
+func GetCalibration(id string, uid uint32, typ uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCalibration"),
+		Fid:        function_get_calibration,
+		Uid:        uid,
+		Result:     &CalibrationData{},
+		Data:       typ,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCalibrationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCalibrationFuture(brick *bricker.Bricker, connectorname string, uid uint32, typ uint8) (*CalibrationData, error) {
+	return device.FutureOf[*CalibrationData](brick, connectorname, GetCalibration("getcalibrationfuture"+device.GenId(), uid, typ, nil))
+}
+
+// Range is the range of a sensor.
+type Range struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a Range.
+func (ra *Range) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(ra, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(ra)
+}
+
+// String fullfill the stringer interface.
+func (ra *Range) String() string {
+	txt := "Range "
+	if ra == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", ra.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (ra *Range) Copy() device.Resulter {
+	if ra == nil {
+		return nil
+	}
+	return &Range{Value: ra.Value}
+}
+
+/*
+Calibration is the calibration of a sensor.
+
+The meaning of the data depends on the type:
+
+	Accelerometer Gain: [mul x, mul y, mul z, div x, div y, div z, 0, 0, 0, 0]
+	Accelerometer Bias: [bias x, bias y, bias z, 0, 0, 0, 0, 0, 0, 0]
+	Magnetometer Gain:  [mul x, mul y, mul z, div x, div y, div z, 0, 0, 0, 0]
+	Magnetometer Bias:  [bias x, bias y, bias z, 0, 0, 0, 0, 0, 0, 0]
+	Gyroscope Gain:     [mul x, mul y, mul z, div x, div y, div z, 0, 0, 0, 0]
+	Gyroscope Bias:     [bias xl, bias yl, bias zl, temp l, bias xh, bias yh, bias zh, temp h, 0, 0]
+*/
+type Calibration struct {
+	Type uint8
+	Data [10]int16
+}
+
+// CalibrationData is the calibration data of a sensor (see Calibration).
+type CalibrationData struct {
+	Data [10]int16
+}
+
+// FromPacket creates from a packet a CalibrationData.
+func (cd *CalibrationData) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(cd, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(cd)
+}
+
+// String fullfill the stringer interface.
+func (cd *CalibrationData) String() string {
+	txt := "Calibration data "
+	if cd == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Data: %v]", cd.Data)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (cd *CalibrationData) Copy() device.Resulter {
+	if cd == nil {
+		return nil
+	}
+	return &CalibrationData{Data: cd.Data}
+}
@@ -0,0 +1,77 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the IMU Brick.
+package imu
+
+const (
+	function_get_acceleration                     = uint8(1)
+	function_get_magnetic_field                   = uint8(2)
+	function_get_angular_velocity                 = uint8(3)
+	function_get_all_data                         = uint8(4)
+	function_get_orientation                      = uint8(5)
+	function_get_quaternion                       = uint8(6)
+	function_get_imu_temperature                  = uint8(7)
+	function_leds_on                              = uint8(8)
+	function_leds_off                             = uint8(9)
+	function_are_leds_on                          = uint8(10)
+	function_set_acceleration_range               = uint8(11)
+	function_get_acceleration_range               = uint8(12)
+	function_set_magnetometer_range               = uint8(13)
+	function_get_magnetometer_range               = uint8(14)
+	function_set_convergence_speed                = uint8(15)
+	function_get_convergence_speed                = uint8(16)
+	function_set_calibration                      = uint8(17)
+	function_get_calibration                      = uint8(18)
+	function_set_acceleration_callback_period     = uint8(19)
+	function_get_acceleration_callback_period     = uint8(20)
+	function_set_magnetic_field_callback_period   = uint8(21)
+	function_get_magnetic_field_callback_period   = uint8(22)
+	function_set_angular_velocity_callback_period = uint8(23)
+	function_get_angular_velocity_callback_period = uint8(24)
+	function_set_all_data_callback_period         = uint8(25)
+	function_get_all_data_callback_period         = uint8(26)
+	function_set_orientation_callback_period      = uint8(27)
+	function_get_orientation_callback_period      = uint8(28)
+	function_set_quaternion_callback_period       = uint8(29)
+	function_get_quaternion_callback_period       = uint8(30)
+	function_orientation_calculation_on           = uint8(37)
+	function_orientation_calculation_off          = uint8(38)
+	function_is_orientation_calculation_on        = uint8(39)
+	function_get_chip_temperature                 = uint8(242)
+	function_reset                                = uint8(243)
+	callback_acceleration                         = uint8(31)
+	callback_magnetic_field                       = uint8(32)
+	callback_angular_velocity                     = uint8(33)
+	callback_all_data                             = uint8(34)
+	callback_orientation                          = uint8(35)
+	callback_quaternion                           = uint8(36)
+	// Calibration types
+	CalibrationTypeAccelerometerGain = uint8(0)
+	CalibrationTypeAccelerometerBias = uint8(1)
+	CalibrationTypeMagnetometerGain  = uint8(2)
+	CalibrationTypeMagnetometerBias  = uint8(3)
+	CalibrationTypeGyroscopeGain     = uint8(4)
+	CalibrationTypeGyroscopeBias     = uint8(5)
+)
+
+// CalibrationTypeName results a string representation of the given calibration type.
+func CalibrationTypeName(t uint8) string {
+	switch t {
+	case CalibrationTypeAccelerometerGain:
+		return "Accelerometer Gain"
+	case CalibrationTypeAccelerometerBias:
+		return "Accelerometer Bias"
+	case CalibrationTypeMagnetometerGain:
+		return "Magnetometer Gain"
+	case CalibrationTypeMagnetometerBias:
+		return "Magnetometer Bias"
+	case CalibrationTypeGyroscopeGain:
+		return "Gyroscope Gain"
+	case CalibrationTypeGyroscopeBias:
+		return "Gyroscope Bias"
+	default:
+		return "Unknown"
+	}
+}
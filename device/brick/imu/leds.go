@@ -0,0 +1,122 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// LedsOn creates the subscriber to turn the orientation and direction LEDs of the IMU on.
+func LedsOn(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "LedsOn"),
+		Fid:        function_leds_on,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// LedsOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func LedsOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, LedsOn("ledsonfuture"+device.GenId(), uid, nil))
+}
+
+// LedsOff creates the subscriber to turn the orientation and direction LEDs of the IMU off.
+func LedsOff(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "LedsOff"),
+		Fid:        function_leds_off,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// LedsOffFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func LedsOffFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, LedsOff("ledsofffuture"+device.GenId(), uid, nil))
+}
+
+// AreLedsOn creates the subscriber to get the state of the orientation and direction LEDs.
+func AreLedsOn(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AreLedsOn"),
+		Fid:        function_are_leds_on,
+		Uid:        uid,
+		Result:     &Leds{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// AreLedsOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func AreLedsOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Leds, error) {
+	return device.FutureOf[*Leds](brick, connectorname, AreLedsOn("areledsonfuture"+device.GenId(), uid, nil))
+}
+
+// AreLedsOnFutureSimple calls the AreLedsOnFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func AreLedsOnFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	l, err := AreLedsOnFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return l.IsOn, nil
+}
+
+// Leds is a type for the return of the AreLedsOn subscriber.
+type Leds struct {
+	IsOn bool // are the leds on
+}
+
+// FromPacket converts the packet payload to the Leds type.
+func (l *Leds) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(l, p); err != nil {
+		return err
+	}
+	lr := new(LedsRaw)
+	err := p.Payload.Decode(lr)
+	if err == nil {
+		l.FromLedsRaw(lr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (l *Leds) String() string {
+	txt := "Leds "
+	if l != nil {
+		txt += fmt.Sprintf("[IsOn: %t]", l.IsOn)
+	} else {
+		txt += "[nil]"
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (l *Leds) Copy() device.Resulter {
+	if l == nil {
+		return nil
+	}
+	return &Leds{IsOn: l.IsOn}
+}
+
+// FromLedsRaw converts a LedsRaw into a Leds.
+func (l *Leds) FromLedsRaw(lr *LedsRaw) {
+	if l == nil || lr == nil {
+		return
+	}
+	l.IsOn = misc.Uint8ToBool(lr.IsOn)
+}
+
+// LedsRaw is a type for raw coding of the leds.
+type LedsRaw struct {
+	IsOn uint8
+}
@@ -0,0 +1,238 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// GetOrientation creates the subscriber to get the orientation (roll, pitch, yaw) of the IMU as euler angles (°/100).
+// Euler angles have a gimbal lock, for a stable orientation use the quaternion (see GetQuaternion).
+func GetOrientation(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetOrientation"),
+		Fid:        function_get_orientation,
+		Uid:        uid,
+		Result:     &Orientation{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetOrientationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetOrientationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Orientation, error) {
+	return device.FutureOf[*Orientation](brick, connectorname, GetOrientation("getorientationfuture"+device.GenId(), uid, nil))
+}
+
+// OrientationCalculationOn creates the subscriber to turn the orientation calculation of the IMU on.
+// Without the calculation the orientation and the quaternion are not computed, this saves processing time.
+func OrientationCalculationOn(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "OrientationCalculationOn"),
+		Fid:        function_orientation_calculation_on,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// OrientationCalculationOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func OrientationCalculationOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, OrientationCalculationOn("orientationcalculationonfuture"+device.GenId(), uid, nil))
+}
+
+// OrientationCalculationOff creates the subscriber to turn the orientation calculation of the IMU off.
+func OrientationCalculationOff(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "OrientationCalculationOff"),
+		Fid:        function_orientation_calculation_off,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// OrientationCalculationOffFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func OrientationCalculationOffFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, OrientationCalculationOff("orientationcalculationofffuture"+device.GenId(), uid, nil))
+}
+
+// IsOrientationCalculationOn creates the subscriber to get the state of the orientation calculation.
+func IsOrientationCalculationOn(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsOrientationCalculationOn"),
+		Fid:        function_is_orientation_calculation_on,
+		Uid:        uid,
+		Result:     &OrientationCalculation{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsOrientationCalculationOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsOrientationCalculationOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*OrientationCalculation, error) {
+	return device.FutureOf[*OrientationCalculation](brick, connectorname, IsOrientationCalculationOn("isorientationcalculationonfuture"+device.GenId(), uid, nil))
+}
+
+// SetConvergenceSpeed creates the subscriber to set the convergence speed (°/s) of the sensor fusion.
+// The convergence speed determines how the different sensors are combined to the orientation.
+// A low value gives a smooth and a high value a fast reacting orientation, the default is 30°/s.
+func SetConvergenceSpeed(id string, uid uint32, cs *ConvergenceSpeed, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetConvergenceSpeed"),
+		Fid:        function_set_convergence_speed,
+		Uid:        uid,
+		Data:       cs,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetConvergenceSpeedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetConvergenceSpeedFuture(brick *bricker.Bricker, connectorname string, uid uint32, cs *ConvergenceSpeed) error {
+	return device.FutureEmpty(brick, connectorname, SetConvergenceSpeed("setconvergencespeedfuture"+device.GenId(), uid, cs, nil))
+}
+
+// GetConvergenceSpeed creates the subscriber to get the convergence speed, which was set with SetConvergenceSpeed.
+func GetConvergenceSpeed(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetConvergenceSpeed"),
+		Fid:        function_get_convergence_speed,
+		Uid:        uid,
+		Result:     &ConvergenceSpeed{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetConvergenceSpeedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetConvergenceSpeedFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ConvergenceSpeed, error) {
+	return device.FutureOf[*ConvergenceSpeed](brick, connectorname, GetConvergenceSpeed("getconvergencespeedfuture"+device.GenId(), uid, nil))
+}
+
+// Orientation is the orientation of the IMU as euler angles in °/100.
+type Orientation struct {
+	Roll  int16 // °/100
+	Pitch int16 // °/100
+	Yaw   int16 // °/100
+}
+
+// FromPacket creates from a packet a Orientation.
+func (o *Orientation) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(o, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(o)
+}
+
+// String fullfill the stringer interface.
+func (o *Orientation) String() string {
+	txt := "Orientation "
+	if o == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Roll: %d, Pitch: %d, Yaw: %d (°/100)]", o.Roll, o.Pitch, o.Yaw)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (o *Orientation) Copy() device.Resulter {
+	if o == nil {
+		return nil
+	}
+	return &Orientation{Roll: o.Roll, Pitch: o.Pitch, Yaw: o.Yaw}
+}
+
+// Degrees converts the euler angles into °.
+func (o *Orientation) Degrees() (roll, pitch, yaw float64) {
+	return float64(o.Roll) / 100.0, float64(o.Pitch) / 100.0, float64(o.Yaw) / 100.0
+}
+
+// OrientationCalculation is the state of the orientation calculation.
+type OrientationCalculation struct {
+	IsOn bool
+}
+
+// FromPacket converts the packet payload to the OrientationCalculation type.
+func (oc *OrientationCalculation) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(oc, p); err != nil {
+		return err
+	}
+	ocr := new(OrientationCalculationRaw)
+	err := p.Payload.Decode(ocr)
+	if err == nil {
+		oc.FromOrientationCalculationRaw(ocr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (oc *OrientationCalculation) String() string {
+	txt := "Orientation calculation "
+	if oc == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[IsOn: %t]", oc.IsOn)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (oc *OrientationCalculation) Copy() device.Resulter {
+	if oc == nil {
+		return nil
+	}
+	return &OrientationCalculation{IsOn: oc.IsOn}
+}
+
+// FromOrientationCalculationRaw converts a OrientationCalculationRaw into a OrientationCalculation.
+func (oc *OrientationCalculation) FromOrientationCalculationRaw(ocr *OrientationCalculationRaw) {
+	if oc == nil || ocr == nil {
+		return
+	}
+	oc.IsOn = misc.Uint8ToBool(ocr.IsOn)
+}
+
+// OrientationCalculationRaw is the real de/encoding type for a OrientationCalculation.
+type OrientationCalculationRaw struct {
+	IsOn uint8
+}
+
+// ConvergenceSpeed is the convergence speed of the sensor fusion in °/s.
+type ConvergenceSpeed struct {
+	Value uint16 // °/s
+}
+
+// FromPacket creates from a packet a ConvergenceSpeed.
+func (cs *ConvergenceSpeed) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(cs, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(cs)
+}
+
+// String fullfill the stringer interface.
+func (cs *ConvergenceSpeed) String() string {
+	txt := "Convergence speed "
+	if cs == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d °/s]", cs.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (cs *ConvergenceSpeed) Copy() device.Resulter {
+	if cs == nil {
+		return nil
+	}
+	return &ConvergenceSpeed{Value: cs.Value}
+}
@@ -0,0 +1,310 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetAccelerationCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AccelerationPeriod is triggered periodically with this period.
+func SetAccelerationCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAccelerationCallbackPeriod"),
+		Fid:        function_set_acceleration_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAccelerationCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAccelerationCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAccelerationCallbackPeriod("setaccelerationcallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAccelerationCallbackPeriod creates a subscriber to get the callback period value.
+func GetAccelerationCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAccelerationCallbackPeriod"),
+		Fid:        function_get_acceleration_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAccelerationCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAccelerationCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAccelerationCallbackPeriod("getaccelerationcallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetMagneticFieldCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// MagneticFieldPeriod is triggered periodically with this period.
+func SetMagneticFieldCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMagneticFieldCallbackPeriod"),
+		Fid:        function_set_magnetic_field_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMagneticFieldCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMagneticFieldCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetMagneticFieldCallbackPeriod("setmagneticfieldcallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetMagneticFieldCallbackPeriod creates a subscriber to get the callback period value.
+func GetMagneticFieldCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMagneticFieldCallbackPeriod"),
+		Fid:        function_get_magnetic_field_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMagneticFieldCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMagneticFieldCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetMagneticFieldCallbackPeriod("getmagneticfieldcallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetAngularVelocityCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AngularVelocityPeriod is triggered periodically with this period.
+func SetAngularVelocityCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAngularVelocityCallbackPeriod"),
+		Fid:        function_set_angular_velocity_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAngularVelocityCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAngularVelocityCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAngularVelocityCallbackPeriod("setangularvelocitycallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAngularVelocityCallbackPeriod creates a subscriber to get the callback period value.
+func GetAngularVelocityCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAngularVelocityCallbackPeriod"),
+		Fid:        function_get_angular_velocity_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAngularVelocityCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAngularVelocityCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAngularVelocityCallbackPeriod("getangularvelocitycallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetAllDataCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AllDataPeriod is triggered periodically with this period.
+func SetAllDataCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAllDataCallbackPeriod"),
+		Fid:        function_set_all_data_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAllDataCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAllDataCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAllDataCallbackPeriod("setalldatacallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAllDataCallbackPeriod creates a subscriber to get the callback period value.
+func GetAllDataCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAllDataCallbackPeriod"),
+		Fid:        function_get_all_data_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAllDataCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAllDataCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAllDataCallbackPeriod("getalldatacallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetOrientationCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// OrientationPeriod is triggered periodically with this period.
+func SetOrientationCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetOrientationCallbackPeriod"),
+		Fid:        function_set_orientation_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetOrientationCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetOrientationCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetOrientationCallbackPeriod("setorientationcallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetOrientationCallbackPeriod creates a subscriber to get the callback period value.
+func GetOrientationCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetOrientationCallbackPeriod"),
+		Fid:        function_get_orientation_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetOrientationCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetOrientationCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetOrientationCallbackPeriod("getorientationcallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetQuaternionCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// QuaternionPeriod is triggered periodically with this period.
+func SetQuaternionCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetQuaternionCallbackPeriod"),
+		Fid:        function_set_quaternion_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetQuaternionCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetQuaternionCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetQuaternionCallbackPeriod("setquaternioncallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetQuaternionCallbackPeriod creates a subscriber to get the callback period value.
+func GetQuaternionCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetQuaternionCallbackPeriod"),
+		Fid:        function_get_quaternion_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetQuaternionCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetQuaternionCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetQuaternionCallbackPeriod("getquaternioncallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// AccelerationPeriod creates a subscriber for the periodical acceleration callback.
+// The period is set with SetAccelerationCallbackPeriod.
+func AccelerationPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AccelerationPeriod"),
+		Fid:        callback_acceleration,
+		Uid:        uid,
+		Result:     &Acceleration{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// MagneticFieldPeriod creates a subscriber for the periodical magnetic field callback.
+// The period is set with SetMagneticFieldCallbackPeriod.
+func MagneticFieldPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "MagneticFieldPeriod"),
+		Fid:        callback_magnetic_field,
+		Uid:        uid,
+		Result:     &MagneticField{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AngularVelocityPeriod creates a subscriber for the periodical angular velocity callback.
+// The period is set with SetAngularVelocityCallbackPeriod.
+func AngularVelocityPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AngularVelocityPeriod"),
+		Fid:        callback_angular_velocity,
+		Uid:        uid,
+		Result:     &AngularVelocity{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AllDataPeriod creates a subscriber for the periodical all data callback.
+// The period is set with SetAllDataCallbackPeriod.
+func AllDataPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AllDataPeriod"),
+		Fid:        callback_all_data,
+		Uid:        uid,
+		Result:     &AllData{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// OrientationPeriod creates a subscriber for the periodical orientation callback.
+// The period is set with SetOrientationCallbackPeriod.
+func OrientationPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "OrientationPeriod"),
+		Fid:        callback_orientation,
+		Uid:        uid,
+		Result:     &Orientation{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// QuaternionPeriod creates a subscriber for the periodical quaternion callback.
+// The period is set with SetQuaternionCallbackPeriod.
+func QuaternionPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "QuaternionPeriod"),
+		Fid:        callback_quaternion,
+		Uid:        uid,
+		Result:     &Quaternion{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
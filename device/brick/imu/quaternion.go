@@ -0,0 +1,152 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	"math"
+)
+
+// GetQuaternion creates the subscriber to get the orientation of the IMU as quaternion.
+// The quaternion has no gimbal lock, use the helpers EulerAngles and RotationMatrix for a conversion.
+func GetQuaternion(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetQuaternion"),
+		Fid:        function_get_quaternion,
+		Uid:        uid,
+		Result:     &Quaternion{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetQuaternionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetQuaternionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Quaternion, error) {
+	return device.FutureOf[*Quaternion](brick, connectorname, GetQuaternion("getquaternionfuture"+device.GenId(), uid, nil))
+}
+
+// Quaternion is the orientation of the IMU as unit quaternion (w + xi + yj + zk).
+type Quaternion struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
+
+// FromPacket creates from a packet a Quaternion.
+func (q *Quaternion) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(q, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(q)
+}
+
+// String fullfill the stringer interface.
+func (q *Quaternion) String() string {
+	txt := "Quaternion "
+	if q == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %f, Y: %f, Z: %f, W: %f]", q.X, q.Y, q.Z, q.W)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (q *Quaternion) Copy() device.Resulter {
+	if q == nil {
+		return nil
+	}
+	return &Quaternion{X: q.X, Y: q.Y, Z: q.Z, W: q.W}
+}
+
+// Norm computes the length of the quaternion, a unit quaternion has the length 1.
+func (q *Quaternion) Norm() float64 {
+	x, y, z, w := q.float64s()
+	return math.Sqrt(x*x + y*y + z*z + w*w)
+}
+
+// Normalize creates a unit quaternion with the same orientation.
+// A quaternion with the length 0 results in the identity (no rotation).
+func (q *Quaternion) Normalize() *Quaternion {
+	n := q.Norm()
+	if n == 0 {
+		return &Quaternion{W: 1}
+	}
+	x, y, z, w := q.float64s()
+	return &Quaternion{X: float32(x / n), Y: float32(y / n), Z: float32(z / n), W: float32(w / n)}
+}
+
+/*
+EulerAngles converts the quaternion into euler angles (°).
+
+The angles use the aerospace convention (intrinsic rotation around z, y and x):
+
+	Roll  - rotation around the x axis (-180° to 180°)
+	Pitch - rotation around the y axis (-90° to 90°)
+	Yaw   - rotation around the z axis (-180° to 180°)
+
+Near a pitch of ±90° the roll and yaw are not unique (gimbal lock),
+in this case the roll is 0 and the yaw contains the whole rotation.
+*/
+func (q *Quaternion) EulerAngles() *EulerAngles {
+	x, y, z, w := q.Normalize().float64s()
+	sinp := 2 * (w*y - z*x)
+	if math.Abs(sinp) >= 1-gimballock {
+		return &EulerAngles{
+			Roll:  0,
+			Pitch: degrees(math.Copysign(math.Pi/2, sinp)),
+			Yaw:   degrees(math.Remainder(-2*math.Copysign(1, sinp)*math.Atan2(x, w), 2*math.Pi))}
+	}
+	return &EulerAngles{
+		Roll:  degrees(math.Atan2(2*(w*x+y*z), 1-2*(x*x+y*y))),
+		Pitch: degrees(math.Asin(sinp)),
+		Yaw:   degrees(math.Atan2(2*(w*z+x*y), 1-2*(y*y+z*z)))}
+}
+
+// RotationMatrix converts the quaternion into a rotation matrix (row major).
+// The matrix rotates a vector of the IMU coordinate system into the reference coordinate system.
+func (q *Quaternion) RotationMatrix() [3][3]float64 {
+	x, y, z, w := q.Normalize().float64s()
+	return [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w)},
+		{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w)},
+		{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y)}}
+}
+
+// gimballock is the tolerance for the detection of the gimbal lock,
+// the components of the quaternion have only single precision.
+const gimballock = 1e-6
+
+// float64s gives the components of the quaternion as float64.
+func (q *Quaternion) float64s() (x, y, z, w float64) {
+	return float64(q.X), float64(q.Y), float64(q.Z), float64(q.W)
+}
+
+// EulerAngles are the angles of a orientation in °.
+type EulerAngles struct {
+	Roll  float64
+	Pitch float64
+	Yaw   float64
+}
+
+// String fullfill the stringer interface.
+func (e *EulerAngles) String() string {
+	txt := "Euler angles "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Roll: %.02f °, Pitch: %.02f °, Yaw: %.02f °]", e.Roll, e.Pitch, e.Yaw)
+	}
+	return txt
+}
+
+// degrees converts radians into °.
+func degrees(rad float64) float64 {
+	return rad * 180.0 / math.Pi
+}
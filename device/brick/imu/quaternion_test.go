@@ -0,0 +1,97 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"math"
+	"testing"
+)
+
+// fromEuler creates a quaternion from euler angles (°) (intrinsic rotation around z, y and x).
+func fromEuler(roll, pitch, yaw float64) *Quaternion {
+	cr, sr := math.Cos(roll*math.Pi/360), math.Sin(roll*math.Pi/360)
+	cp, sp := math.Cos(pitch*math.Pi/360), math.Sin(pitch*math.Pi/360)
+	cy, sy := math.Cos(yaw*math.Pi/360), math.Sin(yaw*math.Pi/360)
+	return &Quaternion{
+		X: float32(sr*cp*cy - cr*sp*sy),
+		Y: float32(cr*sp*cy + sr*cp*sy),
+		Z: float32(cr*cp*sy - sr*sp*cy),
+		W: float32(cr*cp*cy + sr*sp*sy)}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-3
+}
+
+func TestEulerAngles(t *testing.T) {
+	tests := []EulerAngles{{0, 0, 0}, {30, 0, 0}, {0, 45, 0}, {0, 0, -120},
+		{10, -20, 170}, {-170, 60, 5}, {45, 89, 45}}
+	for _, e := range tests {
+		r := fromEuler(e.Roll, e.Pitch, e.Yaw).EulerAngles()
+		if !near(r.Roll, e.Roll) || !near(r.Pitch, e.Pitch) || !near(r.Yaw, e.Yaw) {
+			t.Fatalf("Error TestEulerAngles: wrong angles (%s != %s).", r, &e)
+		}
+	}
+}
+
+func TestEulerAnglesGimbalLock(t *testing.T) {
+	for _, pitch := range []float64{90, -90} {
+		q := fromEuler(20, pitch, 50)
+		r := q.EulerAngles()
+		if !near(r.Roll, 0) || !near(r.Pitch, pitch) {
+			t.Fatalf("Error TestEulerAnglesGimbalLock: wrong angles (%s).", r)
+		}
+		if m, n := q.RotationMatrix(), fromEuler(r.Roll, r.Pitch, r.Yaw).RotationMatrix(); !nearMatrix(m, n) {
+			t.Fatalf("Error TestEulerAnglesGimbalLock: other orientation (%v != %v).", m, n)
+		}
+	}
+}
+
+func TestEulerAnglesGimbalLockYaw(t *testing.T) {
+	tests := []struct{ roll, pitch, yaw float64 }{{-30, 90, 170}, {30, -90, 170}, {-90, 90, 179}, {90, -90, -179}}
+	for _, e := range tests {
+		q := fromEuler(e.roll, e.pitch, e.yaw)
+		r := q.EulerAngles()
+		if r.Yaw < -180 || r.Yaw > 180 {
+			t.Fatalf("Error TestEulerAnglesGimbalLockYaw: yaw out of range (%s).", r)
+		}
+		if m, n := q.RotationMatrix(), fromEuler(r.Roll, r.Pitch, r.Yaw).RotationMatrix(); !nearMatrix(m, n) {
+			t.Fatalf("Error TestEulerAnglesGimbalLockYaw: other orientation (%v != %v).", m, n)
+		}
+	}
+}
+
+func nearMatrix(a, b [3][3]float64) bool {
+	for i := range a {
+		for j := range a[i] {
+			if !near(a[i][j], b[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRotationMatrix(t *testing.T) {
+	identity := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	if m := (&Quaternion{W: 1}).RotationMatrix(); !nearMatrix(m, identity) {
+		t.Fatalf("Error TestRotationMatrix: identity expected (%v).", m)
+	}
+	if m := (&Quaternion{}).RotationMatrix(); !nearMatrix(m, identity) {
+		t.Fatalf("Error TestRotationMatrix: zero quaternion should be the identity (%v).", m)
+	}
+	yaw90 := [3][3]float64{{0, -1, 0}, {1, 0, 0}, {0, 0, 1}}
+	if m := fromEuler(0, 0, 90).RotationMatrix(); !nearMatrix(m, yaw90) {
+		t.Fatalf("Error TestRotationMatrix: wrong yaw rotation (%v).", m)
+	}
+	q := &Quaternion{X: 2, Y: 0, Z: 0, W: 2} // not normalized, roll 90°
+	roll90 := [3][3]float64{{1, 0, 0}, {0, 0, -1}, {0, 1, 0}}
+	if m := q.RotationMatrix(); !nearMatrix(m, roll90) {
+		t.Fatalf("Error TestRotationMatrix: wrong roll rotation (%v).", m)
+	}
+	if n := q.Normalize().Norm(); !near(n, 1) {
+		t.Fatalf("Error TestRotationMatrix: normalized quaternion has length %f.", n)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package imu
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetAcceleration creates the subscriber to get the calibrated acceleration of the x, y and z axis (mG).
+// For periodical values use the AccelerationPeriod callback.
+func GetAcceleration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAcceleration"),
+		Fid:        function_get_acceleration,
+		Uid:        uid,
+		Result:     &Acceleration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAccelerationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAccelerationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Acceleration, error) {
+	return device.FutureOf[*Acceleration](brick, connectorname, GetAcceleration("getaccelerationfuture"+device.GenId(), uid, nil))
+}
+
+// GetMagneticField creates the subscriber to get the calibrated magnetic field of the x, y and z axis (mG/10).
+// For periodical values use the MagneticFieldPeriod callback.
+func GetMagneticField(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMagneticField"),
+		Fid:        function_get_magnetic_field,
+		Uid:        uid,
+		Result:     &MagneticField{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMagneticFieldFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMagneticFieldFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*MagneticField, error) {
+	return device.FutureOf[*MagneticField](brick, connectorname, GetMagneticField("getmagneticfieldfuture"+device.GenId(), uid, nil))
+}
+
+// GetAngularVelocity creates the subscriber to get the calibrated angular velocity of the x, y and z axis (°/14.375s).
+// For periodical values use the AngularVelocityPeriod callback.
+func GetAngularVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAngularVelocity"),
+		Fid:        function_get_angular_velocity,
+		Uid:        uid,
+		Result:     &AngularVelocity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAngularVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAngularVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AngularVelocity, error) {
+	return device.FutureOf[*AngularVelocity](brick, connectorname, GetAngularVelocity("getangularvelocityfuture"+device.GenId(), uid, nil))
+}
+
+// GetAllData creates the subscriber to get the acceleration, magnetic field, angular velocity and temperature at once.
+// For periodical values use the AllDataPeriod callback.
+func GetAllData(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAllData"),
+		Fid:        function_get_all_data,
+		Uid:        uid,
+		Result:     &AllData{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAllDataFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAllDataFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AllData, error) {
+	return device.FutureOf[*AllData](brick, connectorname, GetAllData("getalldatafuture"+device.GenId(), uid, nil))
+}
+
+// GetIMUTemperature creates the subscriber to get the temperature of the IMU (°C/100).
+func GetIMUTemperature(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetIMUTemperature"),
+		Fid:        function_get_imu_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetIMUTemperatureFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetIMUTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetIMUTemperature("getimutemperaturefuture"+device.GenId(), uid, nil))
+}
+
+// Acceleration is the acceleration of the x, y and z axis in mG (1/1000 of the gravity).
+type Acceleration struct {
+	X int16 // mG
+	Y int16 // mG
+	Z int16 // mG
+}
+
+// FromPacket creates from a packet a Acceleration.
+func (a *Acceleration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *Acceleration) String() string {
+	txt := "Acceleration "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %d, Y: %d, Z: %d (mG)]", a.X, a.Y, a.Z)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Acceleration) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Acceleration{X: a.X, Y: a.Y, Z: a.Z}
+}
+
+// G converts the values into g (gravity).
+func (a *Acceleration) G() (x, y, z float64) {
+	return float64(a.X) / 1000.0, float64(a.Y) / 1000.0, float64(a.Z) / 1000.0
+}
+
+// MagneticField is the magnetic field of the x, y and z axis in mG/10.
+type MagneticField struct {
+	X int16 // mG/10
+	Y int16 // mG/10
+	Z int16 // mG/10
+}
+
+// FromPacket creates from a packet a MagneticField.
+func (mf *MagneticField) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(mf, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(mf)
+}
+
+// String fullfill the stringer interface.
+func (mf *MagneticField) String() string {
+	txt := "Magnetic field "
+	if mf == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %d, Y: %d, Z: %d (mG/10)]", mf.X, mf.Y, mf.Z)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (mf *MagneticField) Copy() device.Resulter {
+	if mf == nil {
+		return nil
+	}
+	return &MagneticField{X: mf.X, Y: mf.Y, Z: mf.Z}
+}
+
+// Gauss converts the values into Gauss.
+func (mf *MagneticField) Gauss() (x, y, z float64) {
+	return float64(mf.X) / 10000.0, float64(mf.Y) / 10000.0, float64(mf.Z) / 10000.0
+}
+
+// AngularVelocity is the angular velocity of the x, y and z axis in °/14.375s.
+type AngularVelocity struct {
+	X int16 // °/14.375s
+	Y int16 // °/14.375s
+	Z int16 // °/14.375s
+}
+
+// FromPacket creates from a packet a AngularVelocity.
+func (av *AngularVelocity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(av, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(av)
+}
+
+// String fullfill the stringer interface.
+func (av *AngularVelocity) String() string {
+	txt := "Angular velocity "
+	if av == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %d, Y: %d, Z: %d (°/14.375s)]", av.X, av.Y, av.Z)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (av *AngularVelocity) Copy() device.Resulter {
+	if av == nil {
+		return nil
+	}
+	return &AngularVelocity{X: av.X, Y: av.Y, Z: av.Z}
+}
+
+// DegreesPerSecond converts the values into °/s.
+func (av *AngularVelocity) DegreesPerSecond() (x, y, z float64) {
+	return float64(av.X) / 14.375, float64(av.Y) / 14.375, float64(av.Z) / 14.375
+}
+
+// AllData contains all sensor data of the IMU at once.
+type AllData struct {
+	Acceleration    Acceleration
+	MagneticField   MagneticField
+	AngularVelocity AngularVelocity
+	Temperature     int16 // °C/100
+}
+
+// FromPacket creates from a packet a AllData.
+func (ad *AllData) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(ad, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(ad)
+}
+
+// String fullfill the stringer interface.
+func (ad *AllData) String() string {
+	txt := "All data "
+	if ad == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[%s, %s, %s, Temperature: %d °C/100]",
+			ad.Acceleration.String(), ad.MagneticField.String(), ad.AngularVelocity.String(), ad.Temperature)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (ad *AllData) Copy() device.Resulter {
+	if ad == nil {
+		return nil
+	}
+	return &AllData{
+		Acceleration:    ad.Acceleration,
+		MagneticField:   ad.MagneticField,
+		AngularVelocity: ad.AngularVelocity,
+		Temperature:     ad.Temperature}
+}
@@ -0,0 +1,67 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetStackCurrent creates a subscriber to get the stack current (mA).
+// The stack current is the current of a power supply, if no power supply is connected, the value is 0.
+func GetStackCurrent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackCurrent"),
+		Fid:        function_get_stack_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetStackCurrent("getstackcurrentfuture"+device.GenId(), uid, nil))
+}
+
+// Current result type.
+type Current struct {
+	Value uint16 // mA
+}
+
+// FromPacket creates from a packet a Current.
+func (c *Current) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Current) String() string {
+	txt := "Current "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mA]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Current) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Current{Value: c.Value}
+}
+
+// Float64 converts the current value (mA) into A.
+func (c *Current) Float64() float64 {
+	return float64(c.Value) / 1000.0
+}
@@ -0,0 +1,370 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+	"net"
+)
+
+// SetEthernetConfiguration creates the subscriber to set the configuration of the ethernet extension.
+// The configuration is written into the EEPROM of the ethernet extension,
+// it is only used after the master brick is restarted (see Reset).
+func SetEthernetConfiguration(id string, uid uint32, c *EthernetConfiguration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEthernetConfiguration"),
+		Fid:        function_set_ethernet_configuration,
+		Uid:        uid,
+		Data:       NewEthernetConfigurationRaw(c),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEthernetConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEthernetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *EthernetConfiguration) error {
+	return device.FutureEmpty(brick, connectorname, SetEthernetConfiguration("setethernetconfigurationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetEthernetConfiguration creates the subscriber to get the configuration of the ethernet extension.
+func GetEthernetConfiguration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetEthernetConfiguration"),
+		Fid:        function_get_ethernet_configuration,
+		Uid:        uid,
+		Result:     &EthernetConfiguration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetEthernetConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetEthernetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*EthernetConfiguration, error) {
+	return device.FutureOf[*EthernetConfiguration](brick, connectorname, GetEthernetConfiguration("getethernetconfigurationfuture"+device.GenId(), uid, nil))
+}
+
+// GetEthernetStatus creates the subscriber to get the status of the ethernet extension.
+func GetEthernetStatus(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetEthernetStatus"),
+		Fid:        function_get_ethernet_status,
+		Uid:        uid,
+		Result:     &EthernetStatus{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetEthernetStatusFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetEthernetStatusFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*EthernetStatus, error) {
+	return device.FutureOf[*EthernetStatus](brick, connectorname, GetEthernetStatus("getethernetstatusfuture"+device.GenId(), uid, nil))
+}
+
+// SetEthernetHostname creates the subscriber to set the hostname of the ethernet extension (up to 32 characters).
+// A empty hostname means the default hostname ("WIZnet").
+// The actual hostname is part of the status (GetEthernetStatus).
+func SetEthernetHostname(id string, uid uint32, h *Hostname, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEthernetHostname"),
+		Fid:        function_set_ethernet_hostname,
+		Uid:        uid,
+		Data:       NewEthernetHostnameRaw(h),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEthernetHostnameFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEthernetHostnameFuture(brick *bricker.Bricker, connectorname string, uid uint32, h *Hostname) error {
+	return device.FutureEmpty(brick, connectorname, SetEthernetHostname("setethernethostnamefuture"+device.GenId(), uid, h, nil))
+}
+
+// SetEthernetMACAddress creates the subscriber to set the MAC address of the ethernet extension.
+// The ethernet extension has a MAC address from Tinkerforge, change it only if it is really needed.
+// The actual MAC address is part of the status (GetEthernetStatus).
+func SetEthernetMACAddress(id string, uid uint32, m *MACAddress, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEthernetMACAddress"),
+		Fid:        function_set_ethernet_mac_address,
+		Uid:        uid,
+		Data:       NewMACAddressRaw(m),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEthernetMACAddressFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEthernetMACAddressFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *MACAddress) error {
+	return device.FutureEmpty(brick, connectorname, SetEthernetMACAddress("setethernetmacaddressfuture"+device.GenId(), uid, m, nil))
+}
+
+// SetEthernetWebsocketConfiguration creates the subscriber to set the websocket configuration of the ethernet extension.
+// The sockets (1 to 7) are shared between the normal sockets and the websockets,
+// the default configuration is 3 websockets on port 4280.
+func SetEthernetWebsocketConfiguration(id string, uid uint32, c *WebsocketConfiguration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEthernetWebsocketConfiguration"),
+		Fid:        function_set_ethernet_websocket_configuration,
+		Uid:        uid,
+		Data:       c,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEthernetWebsocketConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEthernetWebsocketConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *WebsocketConfiguration) error {
+	return device.FutureEmpty(brick, connectorname, SetEthernetWebsocketConfiguration("setethernetwebsocketconfigurationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetEthernetWebsocketConfiguration creates the subscriber to get the websocket configuration of the ethernet extension.
+func GetEthernetWebsocketConfiguration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetEthernetWebsocketConfiguration"),
+		Fid:        function_get_ethernet_websocket_configuration,
+		Uid:        uid,
+		Result:     &WebsocketConfiguration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetEthernetWebsocketConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetEthernetWebsocketConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*WebsocketConfiguration, error) {
+	return device.FutureOf[*WebsocketConfiguration](brick, connectorname, GetEthernetWebsocketConfiguration("getethernetwebsocketconfigurationfuture"+device.GenId(), uid, nil))
+}
+
+// SetEthernetAuthenticationSecret creates the subscriber to set the authentication secret of the ethernet extension (up to 64 characters).
+// A empty secret disables the authentication.
+func SetEthernetAuthenticationSecret(id string, uid uint32, s *Secret, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEthernetAuthenticationSecret"),
+		Fid:        function_set_ethernet_authentication_secret,
+		Uid:        uid,
+		Data:       NewSecretRaw(s),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEthernetAuthenticationSecretFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEthernetAuthenticationSecretFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *Secret) error {
+	return device.FutureEmpty(brick, connectorname, SetEthernetAuthenticationSecret("setethernetauthenticationsecretfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetEthernetAuthenticationSecret creates the subscriber to get the authentication secret of the ethernet extension.
+func GetEthernetAuthenticationSecret(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetEthernetAuthenticationSecret"),
+		Fid:        function_get_ethernet_authentication_secret,
+		Uid:        uid,
+		Result:     &Secret{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetEthernetAuthenticationSecretFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetEthernetAuthenticationSecretFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Secret, error) {
+	return device.FutureOf[*Secret](brick, connectorname, GetEthernetAuthenticationSecret("getethernetauthenticationsecretfuture"+device.GenId(), uid, nil))
+}
+
+// EthernetConfiguration is the configuration of the ethernet extension.
+// The addresses are only used with a static IP connection.
+type EthernetConfiguration struct {
+	Connection uint8 // EthernetConnection constant
+	Ip         net.IP
+	SubnetMask net.IP
+	Gateway    net.IP
+	Port       uint16 // default 4223
+}
+
+// FromPacket converts the packet payload to the EthernetConfiguration type.
+func (c *EthernetConfiguration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	cr := new(EthernetConfigurationRaw)
+	err := p.Payload.Decode(cr)
+	if err == nil {
+		c.Connection = cr.Connection
+		c.Ip = IPFromRaw(cr.Ip)
+		c.SubnetMask = IPFromRaw(cr.SubnetMask)
+		c.Gateway = IPFromRaw(cr.Gateway)
+		c.Port = cr.Port
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (c *EthernetConfiguration) String() string {
+	txt := "Ethernet Configuration "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Connection: %s (%d), IP: %s, Subnet Mask: %s, Gateway: %s, Port: %d]",
+			EthernetConnectionName(c.Connection), c.Connection, c.Ip, c.SubnetMask, c.Gateway, c.Port)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *EthernetConfiguration) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &EthernetConfiguration{
+		Connection: c.Connection,
+		Ip:         copyIP(c.Ip),
+		SubnetMask: copyIP(c.SubnetMask),
+		Gateway:    copyIP(c.Gateway),
+		Port:       c.Port}
+}
+
+// EthernetConfigurationRaw is the de/encoding type for EthernetConfiguration.
+type EthernetConfigurationRaw struct {
+	Connection uint8
+	Ip         [4]uint8
+	SubnetMask [4]uint8
+	Gateway    [4]uint8
+	Port       uint16
+}
+
+// NewEthernetConfigurationRaw creates a EthernetConfigurationRaw from a EthernetConfiguration.
+func NewEthernetConfigurationRaw(c *EthernetConfiguration) *EthernetConfigurationRaw {
+	if c == nil {
+		return nil
+	}
+	return &EthernetConfigurationRaw{
+		Connection: c.Connection,
+		Ip:         IPToRaw(c.Ip),
+		SubnetMask: IPToRaw(c.SubnetMask),
+		Gateway:    IPToRaw(c.Gateway),
+		Port:       c.Port}
+}
+
+// EthernetStatus is the status of the ethernet extension.
+type EthernetStatus struct {
+	MacAddress net.HardwareAddr
+	Ip         net.IP
+	SubnetMask net.IP
+	Gateway    net.IP
+	RxCount    uint32
+	TxCount    uint32
+	Hostname   string
+}
+
+// FromPacket converts the packet payload to the EthernetStatus type.
+func (s *EthernetStatus) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	sr := new(EthernetStatusRaw)
+	err := p.Payload.Decode(sr)
+	if err == nil {
+		s.MacAddress = MACFromRaw(sr.MacAddress)
+		s.Ip = IPFromRaw(sr.Ip)
+		s.SubnetMask = IPFromRaw(sr.SubnetMask)
+		s.Gateway = IPFromRaw(sr.Gateway)
+		s.RxCount = sr.RxCount
+		s.TxCount = sr.TxCount
+		s.Hostname = misc.BytesToString(sr.Hostname[:])
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (s *EthernetStatus) String() string {
+	txt := "Ethernet Status "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[MAC Address: %s, IP: %s, Subnet Mask: %s, Gateway: %s, "+
+			"RX Count: %d, TX Count: %d, Hostname: %s]",
+			s.MacAddress, s.Ip, s.SubnetMask, s.Gateway, s.RxCount, s.TxCount, s.Hostname)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *EthernetStatus) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &EthernetStatus{
+		MacAddress: copyMAC(s.MacAddress),
+		Ip:         copyIP(s.Ip),
+		SubnetMask: copyIP(s.SubnetMask),
+		Gateway:    copyIP(s.Gateway),
+		RxCount:    s.RxCount,
+		TxCount:    s.TxCount,
+		Hostname:   s.Hostname}
+}
+
+// EthernetStatusRaw is the decoding type for EthernetStatus.
+type EthernetStatusRaw struct {
+	MacAddress [6]uint8
+	Ip         [4]uint8
+	SubnetMask [4]uint8
+	Gateway    [4]uint8
+	RxCount    uint32
+	TxCount    uint32
+	Hostname   [32]byte
+}
+
+// MACAddress is the type to set the MAC address of the ethernet extension.
+type MACAddress struct {
+	Value net.HardwareAddr
+}
+
+// MACAddressRaw is the encoding type for MACAddress.
+type MACAddressRaw struct {
+	Value [6]uint8
+}
+
+// NewMACAddressRaw creates a MACAddressRaw from a MACAddress.
+func NewMACAddressRaw(m *MACAddress) *MACAddressRaw {
+	if m == nil {
+		return nil
+	}
+	return &MACAddressRaw{Value: MACToRaw(m.Value)}
+}
+
+// WebsocketConfiguration is the websocket configuration of the ethernet extension.
+type WebsocketConfiguration struct {
+	Sockets uint8  // number of websockets (0 to 7)
+	Port    uint16 // default 4280
+}
+
+// FromPacket creates from a packet a WebsocketConfiguration.
+func (c *WebsocketConfiguration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *WebsocketConfiguration) String() string {
+	txt := "Websocket Configuration "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Sockets: %d, Port: %d]", c.Sockets, c.Port)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *WebsocketConfiguration) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &WebsocketConfiguration{Sockets: c.Sockets, Port: c.Port}
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestIPMACRaw(t *testing.T) {
+	ip := net.ParseIP("192.168.0.10")
+	if raw := IPToRaw(ip); raw != [4]uint8{10, 0, 168, 192} {
+		t.Fatalf("Error TestIPMACRaw: wrong raw IP %v.", raw)
+	}
+	if r := IPFromRaw(IPToRaw(ip)); !r.Equal(ip) {
+		t.Fatalf("Error TestIPMACRaw: wrong IP %s.", r)
+	}
+	if raw := IPToRaw(nil); raw != [4]uint8{} {
+		t.Fatalf("Error TestIPMACRaw: missing IP should be 0.0.0.0 (%v).", raw)
+	}
+	mac, _ := net.ParseMAC("40:d8:55:04:a2:1f")
+	if raw := MACToRaw(mac); raw != [6]uint8{0x1f, 0xa2, 0x04, 0x55, 0xd8, 0x40} {
+		t.Fatalf("Error TestIPMACRaw: wrong raw MAC %v.", raw)
+	}
+	if r := MACFromRaw(MACToRaw(mac)); r.String() != mac.String() {
+		t.Fatalf("Error TestIPMACRaw: wrong MAC %s.", r)
+	}
+	if raw := MACToRaw(mac[:4]); raw != [6]uint8{} {
+		t.Fatalf("Error TestIPMACRaw: short MAC should be 00:00:00:00:00:00 (%v).", raw)
+	}
+}
+
+func TestEthernetConfiguration(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	c := &EthernetConfiguration{
+		Connection: EthernetConnectionStaticIP,
+		Ip:         net.ParseIP("192.168.0.10"),
+		SubnetMask: net.ParseIP("255.255.255.0"),
+		Gateway:    net.ParseIP("192.168.0.1"),
+		Port:       4223}
+	if err := SetEthernetConfigurationFuture(b, "virtual", uid, c); err != nil {
+		t.Fatalf("Error TestEthernetConfiguration: SetEthernetConfiguration failed (%s).", err)
+	}
+	want := []byte{EthernetConnectionStaticIP, 10, 0, 168, 192, 0, 255, 255, 255, 1, 0, 168, 192, 0x7f, 0x10}
+	if r := f.Request(function_set_ethernet_configuration); !bytes.Equal(r, want) {
+		t.Fatalf("Error TestEthernetConfiguration: wrong payload % x.", r)
+	}
+	r, err := GetEthernetConfigurationFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestEthernetConfiguration: GetEthernetConfiguration failed (%s).", err)
+	}
+	if r.Connection != c.Connection || !r.Ip.Equal(c.Ip) || !r.SubnetMask.Equal(c.SubnetMask) ||
+		!r.Gateway.Equal(c.Gateway) || r.Port != c.Port {
+		t.Fatalf("Error TestEthernetConfiguration: wrong configuration %s.", r)
+	}
+}
+
+func TestEthernetStatus(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	status := []byte{0x1f, 0xa2, 0x04, 0x55, 0xd8, 0x40, // MAC address
+		10, 0, 168, 192, 0, 255, 255, 255, 1, 0, 168, 192, // IP, subnet mask, gateway
+		0x39, 0x30, 0, 0, 0x31, 0xd4, 0, 0} // RX count, TX count
+	hostname := make([]byte, 32)
+	copy(hostname, "WIZnetEFFEED")
+	f.Respond(function_get_ethernet_status, append(status, hostname...))
+	s, err := GetEthernetStatusFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestEthernetStatus: GetEthernetStatus failed (%s).", err)
+	}
+	if s.MacAddress.String() != "40:d8:55:04:a2:1f" || s.Ip.String() != "192.168.0.10" ||
+		s.SubnetMask.String() != "255.255.255.0" || s.Gateway.String() != "192.168.0.1" ||
+		s.RxCount != 12345 || s.TxCount != 54321 || s.Hostname != "WIZnetEFFEED" {
+		t.Fatalf("Error TestEthernetStatus: wrong status %s.", s)
+	}
+}
+
+func TestEthernetMACAddress(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	mac, _ := net.ParseMAC("40:d8:55:04:a2:1f")
+	if err := SetEthernetMACAddressFuture(b, "virtual", uid, &MACAddress{Value: mac}); err != nil {
+		t.Fatalf("Error TestEthernetMACAddress: SetEthernetMACAddress failed (%s).", err)
+	}
+	want := []byte{0x1f, 0xa2, 0x04, 0x55, 0xd8, 0x40}
+	if r := f.Request(function_set_ethernet_mac_address); !bytes.Equal(r, want) {
+		t.Fatalf("Error TestEthernetMACAddress: wrong payload % x.", r)
+	}
+}
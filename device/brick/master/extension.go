@@ -0,0 +1,265 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetExtensionType creates a subscriber to write the type of the extension into the EEPROM of the extension.
+// The extension is 0 or 1 (the master brick supports two extensions).
+// Is only needed after the replacement of the EEPROM of an extension.
+func SetExtensionType(id string, uid uint32, et *ExtensionConfiguration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetExtensionType"),
+		Fid:        function_set_extension_type,
+		Uid:        uid,
+		Data:       et,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetExtensionTypeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetExtensionTypeFuture(brick *bricker.Bricker, connectorname string, uid uint32, et *ExtensionConfiguration) error {
+	return device.FutureEmpty(brick, connectorname, SetExtensionType("setextensiontypefuture"+device.GenId(), uid, et, nil))
+}
+
+// GetExtensionType creates a subscriber to get the type of the extension (0 or 1).
+func GetExtensionType(id string, uid uint32, e *Extension, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetExtensionType"),
+		Fid:        function_get_extension_type,
+		Uid:        uid,
+		Data:       e,
+		Result:     &ExtensionType{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetExtensionTypeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetExtensionTypeFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *Extension) (*ExtensionType, error) {
+	return device.FutureOf[*ExtensionType](brick, connectorname, GetExtensionType("getextensiontypefuture"+device.GenId(), uid, e, nil))
+}
+
+// IsChibiPresent creates a subscriber to check, if a chibi extension is available.
+func IsChibiPresent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return isPresent(device.FallbackId(id, "IsChibiPresent"), function_is_chibi_present, uid, handler)
+}
+
+// IsChibiPresentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is false and the error is returned.
+func IsChibiPresentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	return isPresentFuture(brick, connectorname, IsChibiPresent("ischibipresentfuture"+device.GenId(), uid, nil))
+}
+
+// IsRS485Present creates a subscriber to check, if a RS485 extension is available.
+func IsRS485Present(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return isPresent(device.FallbackId(id, "IsRS485Present"), function_is_rs485_present, uid, handler)
+}
+
+// IsRS485PresentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is false and the error is returned.
+func IsRS485PresentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	return isPresentFuture(brick, connectorname, IsRS485Present("isrs485presentfuture"+device.GenId(), uid, nil))
+}
+
+// IsWifiPresent creates a subscriber to check, if a WIFI extension is available.
+func IsWifiPresent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return isPresent(device.FallbackId(id, "IsWifiPresent"), function_is_wifi_present, uid, handler)
+}
+
+// IsWifiPresentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is false and the error is returned.
+func IsWifiPresentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	return isPresentFuture(brick, connectorname, IsWifiPresent("iswifipresentfuture"+device.GenId(), uid, nil))
+}
+
+// IsEthernetPresent creates a subscriber to check, if a ethernet extension is available.
+func IsEthernetPresent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return isPresent(device.FallbackId(id, "IsEthernetPresent"), function_is_ethernet_present, uid, handler)
+}
+
+// IsEthernetPresentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is false and the error is returned.
+func IsEthernetPresentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	return isPresentFuture(brick, connectorname, IsEthernetPresent("isethernetpresentfuture"+device.GenId(), uid, nil))
+}
+
+// GetConnectionType creates the subscriber to get the type of the connection,
+// over which the master brick is connected to the brick daemon (ConnectionType constant).
+func GetConnectionType(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetConnectionType"),
+		Fid:        function_get_connection_type,
+		Uid:        uid,
+		Result:     &ConnectionType{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetConnectionTypeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetConnectionTypeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ConnectionType, error) {
+	return device.FutureOf[*ConnectionType](brick, connectorname, GetConnectionType("getconnectiontypefuture"+device.GenId(), uid, nil))
+}
+
+// Internal function: isPresent creates the subscriber for a presence check of an extension.
+func isPresent(id string, fid uint8, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         id,
+		Fid:        fid,
+		Uid:        uid,
+		Result:     &Present{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// Internal function: isPresentFuture calls the presence check synchronized and returns only the result.
+func isPresentFuture(brick *bricker.Bricker, connectorname string, d *device.Device) (bool, error) {
+	p, err := device.FutureOf[*Present](brick, connectorname, d)
+	if err != nil {
+		return false, err
+	}
+	return p.IsPresent, nil
+}
+
+// Extension is the number of an extension, the master brick supports two extensions (0 and 1).
+type Extension struct {
+	Value uint8
+}
+
+// ExtensionConfiguration is the type of an extension for writing into the EEPROM of the extension.
+type ExtensionConfiguration struct {
+	Extension uint8  // 0 or 1
+	Type      uint32 // ExtensionType constant
+}
+
+// ExtensionType is the result type of the GetExtensionType subscriber.
+type ExtensionType struct {
+	Value uint32
+}
+
+// FromPacket creates from a packet a ExtensionType.
+func (et *ExtensionType) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(et, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(et)
+}
+
+// Name results the name of the extension type.
+func (et *ExtensionType) Name() string {
+	if et == nil {
+		return ""
+	}
+	return ExtensionTypeName(et.Value)
+}
+
+// String fullfill the stringer interface.
+func (et *ExtensionType) String() string {
+	txt := "Extension Type "
+	if et == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Name: %s]", et.Value, et.Name())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (et *ExtensionType) Copy() device.Resulter {
+	if et == nil {
+		return nil
+	}
+	return &ExtensionType{Value: et.Value}
+}
+
+// Present is the result type of the presence checks of the extensions.
+type Present struct {
+	IsPresent bool
+}
+
+// FromPacket converts the packet payload to the Present type.
+func (pr *Present) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(pr, p); err != nil {
+		return err
+	}
+	prr := new(PresentRaw)
+	err := p.Payload.Decode(prr)
+	if err == nil {
+		pr.IsPresent = misc.Uint8ToBool(prr.IsPresent)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (pr *Present) String() string {
+	txt := "Present "
+	if pr == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[IsPresent: %t]", pr.IsPresent)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (pr *Present) Copy() device.Resulter {
+	if pr == nil {
+		return nil
+	}
+	return &Present{IsPresent: pr.IsPresent}
+}
+
+// PresentRaw is the raw coding of the Present type.
+type PresentRaw struct {
+	IsPresent uint8
+}
+
+// ConnectionType is the result type of the GetConnectionType subscriber.
+type ConnectionType struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a ConnectionType.
+func (ct *ConnectionType) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(ct, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(ct)
+}
+
+// Name results the name of the connection type.
+func (ct *ConnectionType) Name() string {
+	if ct == nil {
+		return ""
+	}
+	return ConnectionTypeName(ct.Value)
+}
+
+// String fullfill the stringer interface.
+func (ct *ConnectionType) String() string {
+	txt := "Connection Type "
+	if ct == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Name: %s]", ct.Value, ct.Name())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (ct *ConnectionType) Copy() device.Resulter {
+	if ct == nil {
+		return nil
+	}
+	return &ConnectionType{Value: ct.Value}
+}
@@ -0,0 +1,218 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Master Brick.
+package master
+
+const (
+	function_get_stack_voltage                    = uint8(1)
+	function_get_stack_current                    = uint8(2)
+	function_set_extension_type                   = uint8(3)
+	function_get_extension_type                   = uint8(4)
+	function_is_chibi_present                     = uint8(5)
+	function_is_rs485_present                     = uint8(18)
+	function_is_wifi_present                      = uint8(26)
+	function_set_wifi_configuration               = uint8(27)
+	function_get_wifi_configuration               = uint8(28)
+	function_set_wifi_encryption                  = uint8(29)
+	function_get_wifi_encryption                  = uint8(30)
+	function_get_wifi_status                      = uint8(31)
+	function_refresh_wifi_status                  = uint8(32)
+	function_set_wifi_power_mode                  = uint8(35)
+	function_get_wifi_power_mode                  = uint8(36)
+	function_get_wifi_buffer_info                 = uint8(37)
+	function_set_wifi_regulatory_domain           = uint8(38)
+	function_get_wifi_regulatory_domain           = uint8(39)
+	function_get_usb_voltage                      = uint8(40)
+	function_set_long_wifi_key                    = uint8(41)
+	function_get_long_wifi_key                    = uint8(42)
+	function_set_wifi_hostname                    = uint8(43)
+	function_get_wifi_hostname                    = uint8(44)
+	function_set_stack_current_callback_period    = uint8(45)
+	function_get_stack_current_callback_period    = uint8(46)
+	function_set_stack_voltage_callback_period    = uint8(47)
+	function_get_stack_voltage_callback_period    = uint8(48)
+	function_set_usb_voltage_callback_period      = uint8(49)
+	function_get_usb_voltage_callback_period      = uint8(50)
+	function_set_stack_current_callback_threshold = uint8(51)
+	function_get_stack_current_callback_threshold = uint8(52)
+	function_set_stack_voltage_callback_threshold = uint8(53)
+	function_get_stack_voltage_callback_threshold = uint8(54)
+	function_set_usb_voltage_callback_threshold   = uint8(55)
+	function_get_usb_voltage_callback_threshold   = uint8(56)
+	function_set_debounce_period                  = uint8(57)
+	function_get_debounce_period                  = uint8(58)
+	function_is_ethernet_present                  = uint8(65)
+	function_set_ethernet_configuration           = uint8(66)
+	function_get_ethernet_configuration           = uint8(67)
+	function_get_ethernet_status                  = uint8(68)
+	function_set_ethernet_hostname                = uint8(69)
+	function_set_ethernet_mac_address             = uint8(70)
+	function_set_ethernet_websocket_configuration = uint8(71)
+	function_get_ethernet_websocket_configuration = uint8(72)
+	function_set_ethernet_authentication_secret   = uint8(73)
+	function_get_ethernet_authentication_secret   = uint8(74)
+	function_set_wifi_authentication_secret       = uint8(75)
+	function_get_wifi_authentication_secret       = uint8(76)
+	function_get_connection_type                  = uint8(77)
+	function_get_chip_temperature                 = uint8(242)
+	function_reset                                = uint8(243)
+	callback_stack_current                        = uint8(59)
+	callback_stack_voltage                        = uint8(60)
+	callback_usb_voltage                          = uint8(61)
+	callback_stack_current_reached                = uint8(62)
+	callback_stack_voltage_reached                = uint8(63)
+	callback_usb_voltage_reached                  = uint8(64)
+	// Extensions
+	ExtensionTypeChibi    = uint32(1)
+	ExtensionTypeRS485    = uint32(2)
+	ExtensionTypeWifi     = uint32(3)
+	ExtensionTypeEthernet = uint32(4)
+	ExtensionTypeWifi2    = uint32(5)
+	// Connections of the WIFI extension
+	WifiConnectionDHCP                = uint8(0)
+	WifiConnectionStaticIP            = uint8(1)
+	WifiConnectionAccessPointDHCP     = uint8(2)
+	WifiConnectionAccessPointStaticIP = uint8(3)
+	WifiConnectionAdHocDHCP           = uint8(4)
+	WifiConnectionAdHocStaticIP       = uint8(5)
+	// Encryptions of the WIFI extension
+	WifiEncryptionWPA           = uint8(0) // WPA/WPA2
+	WifiEncryptionWPAEnterprise = uint8(1) // WPA Enterprise (EAP-FAST, EAP-TLS, EAP-TTLS, PEAP)
+	WifiEncryptionWEP           = uint8(2)
+	WifiEncryptionNoEncryption  = uint8(3)
+	// States of the WIFI extension
+	WifiStateDisassociated  = uint8(0)
+	WifiStateAssociated     = uint8(1)
+	WifiStateAssociating    = uint8(2)
+	WifiStateError          = uint8(3)
+	WifiStateNotInitialized = uint8(255)
+	// Power modes of the WIFI extension
+	WifiPowerModeFullSpeed = uint8(0)
+	WifiPowerModeLowPower  = uint8(1)
+	// Regulatory domains of the WIFI extension
+	WifiDomainChannel1To11 = uint8(0) // FCC
+	WifiDomainChannel1To13 = uint8(1) // ETSI
+	WifiDomainChannel1To14 = uint8(2) // TELEC
+	// Connections of the ethernet extension
+	EthernetConnectionDHCP     = uint8(0)
+	EthernetConnectionStaticIP = uint8(1)
+	// Connection types of the master brick to the brick daemon
+	ConnectionTypeNone     = uint8(0)
+	ConnectionTypeUSB      = uint8(1)
+	ConnectionTypeSPIStack = uint8(2)
+	ConnectionTypeChibi    = uint8(3)
+	ConnectionTypeRS485    = uint8(4)
+	ConnectionTypeWifi     = uint8(5)
+	ConnectionTypeEthernet = uint8(6)
+	ConnectionTypeWifi2    = uint8(7)
+)
+
+// ExtensionTypeName results a string representation of the given extension type.
+func ExtensionTypeName(t uint32) string {
+	switch t {
+	case ExtensionTypeChibi:
+		return "Chibi"
+	case ExtensionTypeRS485:
+		return "RS485"
+	case ExtensionTypeWifi:
+		return "WIFI"
+	case ExtensionTypeEthernet:
+		return "Ethernet"
+	case ExtensionTypeWifi2:
+		return "WIFI 2.0"
+	default:
+		return "Unknown"
+	}
+}
+
+// WifiConnectionName results a string representation of the given WIFI connection.
+func WifiConnectionName(c uint8) string {
+	switch c {
+	case WifiConnectionDHCP:
+		return "DHCP"
+	case WifiConnectionStaticIP:
+		return "Static IP"
+	case WifiConnectionAccessPointDHCP:
+		return "Access Point: DHCP"
+	case WifiConnectionAccessPointStaticIP:
+		return "Access Point: Static IP"
+	case WifiConnectionAdHocDHCP:
+		return "Ad Hoc: DHCP"
+	case WifiConnectionAdHocStaticIP:
+		return "Ad Hoc: Static IP"
+	default:
+		return "Unknown"
+	}
+}
+
+// WifiEncryptionName results a string representation of the given WIFI encryption.
+func WifiEncryptionName(e uint8) string {
+	switch e {
+	case WifiEncryptionWPA:
+		return "WPA/WPA2"
+	case WifiEncryptionWPAEnterprise:
+		return "WPA Enterprise"
+	case WifiEncryptionWEP:
+		return "WEP"
+	case WifiEncryptionNoEncryption:
+		return "No Encryption"
+	default:
+		return "Unknown"
+	}
+}
+
+// WifiStateName results a string representation of the given WIFI state.
+func WifiStateName(s uint8) string {
+	switch s {
+	case WifiStateDisassociated:
+		return "Disassociated"
+	case WifiStateAssociated:
+		return "Associated"
+	case WifiStateAssociating:
+		return "Associating"
+	case WifiStateError:
+		return "Error"
+	case WifiStateNotInitialized:
+		return "Not initialized yet"
+	default:
+		return "Unknown"
+	}
+}
+
+// EthernetConnectionName results a string representation of the given ethernet connection.
+func EthernetConnectionName(c uint8) string {
+	switch c {
+	case EthernetConnectionDHCP:
+		return "DHCP"
+	case EthernetConnectionStaticIP:
+		return "Static IP"
+	default:
+		return "Unknown"
+	}
+}
+
+// ConnectionTypeName results a string representation of the given connection type.
+func ConnectionTypeName(t uint8) string {
+	switch t {
+	case ConnectionTypeNone:
+		return "None"
+	case ConnectionTypeUSB:
+		return "USB"
+	case ConnectionTypeSPIStack:
+		return "SPI Stack"
+	case ConnectionTypeChibi:
+		return "Chibi"
+	case ConnectionTypeRS485:
+		return "RS485"
+	case ConnectionTypeWifi:
+		return "WIFI"
+	case ConnectionTypeEthernet:
+		return "Ethernet"
+	case ConnectionTypeWifi2:
+		return "WIFI 2.0"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"bytes"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/util/fakedevice"
+	"testing"
+)
+
+const uid = uint32(4711)
+
+// newMaster creates a fake master brick, the configuration setters store their payload for the getters.
+func newMaster(t *testing.T) (*bricker.Bricker, *fakedevice.Device) {
+	f := fakedevice.New(t, uid)
+	f.Store(function_set_ethernet_configuration, function_get_ethernet_configuration)
+	f.Store(function_set_wifi_configuration, function_get_wifi_configuration)
+	return f.Bricker(), f
+}
+
+func TestVoltageCurrent(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	f.Respond(function_get_stack_voltage, &Voltage{Value: 12500})
+	f.Respond(function_get_stack_current, &Current{Value: 1750})
+	f.Respond(function_get_usb_voltage, &Voltage{Value: 5020})
+	if v, err := GetStackVoltageFuture(b, "virtual", uid); err != nil || v.Value != 12500 || v.Float64() != 12.5 {
+		t.Fatalf("Error TestVoltageCurrent: wrong stack voltage (%v, %s).", v, err)
+	}
+	if c, err := GetStackCurrentFuture(b, "virtual", uid); err != nil || c.Value != 1750 || c.Float64() != 1.75 {
+		t.Fatalf("Error TestVoltageCurrent: wrong stack current (%v, %s).", c, err)
+	}
+	if v, err := GetUSBVoltageFuture(b, "virtual", uid); err != nil || v.Value != 5020 {
+		t.Fatalf("Error TestVoltageCurrent: wrong USB voltage (%v, %s).", v, err)
+	}
+}
+
+func TestChipTemperature(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	f.Respond(function_get_chip_temperature, &Temperature{Value: -1250})
+	if r, err := GetChipTemperatureFuture(b, "virtual", uid); err != nil || r.Value != -1250 || r.Float64() != -12.5 {
+		t.Fatalf("Error TestChipTemperature: wrong temperature (%v, %s).", r, err)
+	}
+}
+
+func TestExtension(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	ec := &ExtensionConfiguration{Extension: 1, Type: ExtensionTypeEthernet}
+	if err := SetExtensionTypeFuture(b, "virtual", uid, ec); err != nil {
+		t.Fatalf("Error TestExtension: SetExtensionType failed (%s).", err)
+	}
+	if r := f.Request(function_set_extension_type); !bytes.Equal(r, []byte{1, 4, 0, 0, 0}) {
+		t.Fatalf("Error TestExtension: wrong payload % x.", r)
+	}
+	f.Respond(function_get_extension_type, &ExtensionType{Value: ExtensionTypeWifi2})
+	r, err := GetExtensionTypeFuture(b, "virtual", uid, &Extension{Value: 1})
+	if err != nil || r.Value != ExtensionTypeWifi2 || r.Name() != ExtensionTypeName(ExtensionTypeWifi2) {
+		t.Fatalf("Error TestExtension: wrong extension type (%v, %s).", r, err)
+	}
+	if r := f.Request(function_get_extension_type); !bytes.Equal(r, []byte{1}) {
+		t.Fatalf("Error TestExtension: wrong extension % x.", r)
+	}
+}
+
+func TestPresent(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	f.Respond(function_is_chibi_present, &PresentRaw{IsPresent: 0})
+	f.Respond(function_is_rs485_present, &PresentRaw{IsPresent: 0})
+	f.Respond(function_is_wifi_present, &PresentRaw{IsPresent: 1})
+	f.Respond(function_is_ethernet_present, &PresentRaw{IsPresent: 1})
+	checks := []struct {
+		name string
+		call func(*bricker.Bricker, string, uint32) (bool, error)
+		want bool
+	}{{name: "chibi", call: IsChibiPresentFuture, want: false},
+		{name: "rs485", call: IsRS485PresentFuture, want: false},
+		{name: "wifi", call: IsWifiPresentFuture, want: true},
+		{name: "ethernet", call: IsEthernetPresentFuture, want: true}}
+	for _, c := range checks {
+		if p, err := c.call(b, "virtual", uid); err != nil || p != c.want {
+			t.Fatalf("Error TestPresent: wrong presence of %s (%t, %s).", c.name, p, err)
+		}
+	}
+}
+
+func TestConnectionType(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	f.Respond(function_get_connection_type, &ConnectionType{Value: ConnectionTypeEthernet})
+	r, err := GetConnectionTypeFuture(b, "virtual", uid)
+	if err != nil || r.Value != ConnectionTypeEthernet || r.Name() != ConnectionTypeName(ConnectionTypeEthernet) {
+		t.Fatalf("Error TestConnectionType: wrong connection type (%v, %s).", r, err)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+	"net"
+)
+
+// The addresses (IP and MAC) of the extensions are transmitted in reversed byte order,
+// the IP 192.168.0.10 is transmitted as 10, 0, 168, 192.
+
+// IPFromRaw converts a transmitted IP address into a net.IP.
+func IPFromRaw(raw [4]uint8) net.IP {
+	return net.IPv4(raw[3], raw[2], raw[1], raw[0]).To4()
+}
+
+// IPToRaw converts a IP address into the transmitted format.
+// A missing or not IPv4 address results in 0.0.0.0.
+func IPToRaw(ip net.IP) [4]uint8 {
+	var raw [4]uint8
+	if ip4 := ip.To4(); ip4 != nil {
+		raw[0], raw[1], raw[2], raw[3] = ip4[3], ip4[2], ip4[1], ip4[0]
+	}
+	return raw
+}
+
+// MACFromRaw converts a transmitted MAC address into a net.HardwareAddr.
+func MACFromRaw(raw [6]uint8) net.HardwareAddr {
+	mac := make(net.HardwareAddr, 6)
+	for i := range raw {
+		mac[i] = raw[5-i]
+	}
+	return mac
+}
+
+// MACToRaw converts a MAC address into the transmitted format.
+// A missing or not 6 bytes long address results in 00:00:00:00:00:00.
+func MACToRaw(mac net.HardwareAddr) [6]uint8 {
+	var raw [6]uint8
+	if len(mac) == 6 {
+		for i := range raw {
+			raw[i] = mac[5-i]
+		}
+	}
+	return raw
+}
+
+// Internal function: copyIP creates a copy of the IP address.
+func copyIP(ip net.IP) net.IP {
+	if ip == nil {
+		return nil
+	}
+	return append(net.IP{}, ip...)
+}
+
+// Internal function: copyMAC creates a copy of the MAC address.
+func copyMAC(mac net.HardwareAddr) net.HardwareAddr {
+	if mac == nil {
+		return nil
+	}
+	return append(net.HardwareAddr{}, mac...)
+}
+
+// Secret is the type for the authentication secrets and the long WIFI key (maximal 64 characters).
+type Secret struct {
+	Value string
+}
+
+// FromPacket converts the packet payload to the Secret type.
+func (s *Secret) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	sr := new(SecretRaw)
+	err := p.Payload.Decode(sr)
+	if err == nil {
+		s.Value = misc.BytesToString(sr.Value[:])
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+// The value of the secret is not shown.
+func (s *Secret) String() string {
+	txt := "Secret "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Length: %d]", len(s.Value))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *Secret) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &Secret{Value: s.Value}
+}
+
+// SecretRaw is the de/encoding type for Secret.
+type SecretRaw struct {
+	Value [64]byte
+}
+
+// NewSecretRaw creates a SecretRaw from a Secret.
+func NewSecretRaw(s *Secret) *SecretRaw {
+	if s == nil {
+		return nil
+	}
+	sr := new(SecretRaw)
+	copy(sr.Value[:], s.Value)
+	return sr
+}
+
+// Hostname is the type for the hostnames of the extensions.
+// The WIFI extension supports up to 16 characters, the ethernet extension up to 32 characters.
+type Hostname struct {
+	Value string
+}
+
+// FromPacket converts the packet payload to the Hostname type.
+func (h *Hostname) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(h, p); err != nil {
+		return err
+	}
+	hr := new(WifiHostnameRaw)
+	err := p.Payload.Decode(hr)
+	if err == nil {
+		h.Value = misc.BytesToString(hr.Value[:])
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (h *Hostname) String() string {
+	txt := "Hostname "
+	if h == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s]", h.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (h *Hostname) Copy() device.Resulter {
+	if h == nil {
+		return nil
+	}
+	return &Hostname{Value: h.Value}
+}
+
+// WifiHostnameRaw is the de/encoding type for the hostname of the WIFI extension.
+type WifiHostnameRaw struct {
+	Value [16]byte
+}
+
+// NewWifiHostnameRaw creates a WifiHostnameRaw from a Hostname.
+func NewWifiHostnameRaw(h *Hostname) *WifiHostnameRaw {
+	if h == nil {
+		return nil
+	}
+	hr := new(WifiHostnameRaw)
+	copy(hr.Value[:], h.Value)
+	return hr
+}
+
+// EthernetHostnameRaw is the encoding type for the hostname of the ethernet extension.
+type EthernetHostnameRaw struct {
+	Value [32]byte
+}
+
+// NewEthernetHostnameRaw creates a EthernetHostnameRaw from a Hostname.
+func NewEthernetHostnameRaw(h *Hostname) *EthernetHostnameRaw {
+	if h == nil {
+		return nil
+	}
+	hr := new(EthernetHostnameRaw)
+	copy(hr.Value[:], h.Value)
+	return hr
+}
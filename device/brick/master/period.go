@@ -0,0 +1,160 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetStackCurrentCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// StackCurrentPeriod is only triggered if the stack current has changed since the last triggering.
+func SetStackCurrentCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStackCurrentCallbackPeriod"),
+		Fid:        function_set_stack_current_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStackCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStackCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetStackCurrentCallbackPeriod("setstackcurrentcallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetStackCurrentCallbackPeriod creates a subscriber to get the callback period value.
+func GetStackCurrentCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackCurrentCallbackPeriod"),
+		Fid:        function_get_stack_current_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetStackCurrentCallbackPeriod("getstackcurrentcallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetStackVoltageCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// StackVoltagePeriod is only triggered if the stack voltage has changed since the last triggering.
+func SetStackVoltageCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStackVoltageCallbackPeriod"),
+		Fid:        function_set_stack_voltage_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStackVoltageCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStackVoltageCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetStackVoltageCallbackPeriod("setstackvoltagecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetStackVoltageCallbackPeriod creates a subscriber to get the callback period value.
+func GetStackVoltageCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackVoltageCallbackPeriod"),
+		Fid:        function_get_stack_voltage_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackVoltageCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackVoltageCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetStackVoltageCallbackPeriod("getstackvoltagecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetUSBVoltageCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// USBVoltagePeriod is only triggered if the USB voltage has changed since the last triggering.
+func SetUSBVoltageCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetUSBVoltageCallbackPeriod"),
+		Fid:        function_set_usb_voltage_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetUSBVoltageCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetUSBVoltageCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetUSBVoltageCallbackPeriod("setusbvoltagecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetUSBVoltageCallbackPeriod creates a subscriber to get the callback period value.
+func GetUSBVoltageCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetUSBVoltageCallbackPeriod"),
+		Fid:        function_get_usb_voltage_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetUSBVoltageCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetUSBVoltageCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetUSBVoltageCallbackPeriod("getusbvoltagecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// StackCurrentPeriod creates a subscriber for the periodical stack current callback.
+// Is only triggered if the stack current changed, since last triggering.
+func StackCurrentPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StackCurrentPeriod"),
+		Fid:        callback_stack_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// StackVoltagePeriod creates a subscriber for the periodical stack voltage callback.
+// Is only triggered if the stack voltage changed, since last triggering.
+func StackVoltagePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StackVoltagePeriod"),
+		Fid:        callback_stack_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// USBVoltagePeriod creates a subscriber for the periodical USB voltage callback.
+// Is only triggered if the USB voltage changed, since last triggering.
+func USBVoltagePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "USBVoltagePeriod"),
+		Fid:        callback_usb_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
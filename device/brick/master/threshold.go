@@ -0,0 +1,154 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetStackCurrentCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetStackCurrentCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStackCurrentCallbackThreshold"),
+		Fid:        function_set_stack_current_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStackCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStackCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetStackCurrentCallbackThreshold("setstackcurrentcallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetStackCurrentCallbackThreshold creates the subscriber to get the callback thresold.
+func GetStackCurrentCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackCurrentCallbackThreshold"),
+		Fid:        function_get_stack_current_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetStackCurrentCallbackThreshold("getstackcurrentcallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetStackVoltageCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetStackVoltageCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStackVoltageCallbackThreshold"),
+		Fid:        function_set_stack_voltage_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStackVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStackVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetStackVoltageCallbackThreshold("setstackvoltagecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetStackVoltageCallbackThreshold creates the subscriber to get the callback thresold.
+func GetStackVoltageCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackVoltageCallbackThreshold"),
+		Fid:        function_get_stack_voltage_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetStackVoltageCallbackThreshold("getstackvoltagecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetUSBVoltageCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetUSBVoltageCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetUSBVoltageCallbackThreshold"),
+		Fid:        function_set_usb_voltage_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetUSBVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetUSBVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetUSBVoltageCallbackThreshold("setusbvoltagecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetUSBVoltageCallbackThreshold creates the subscriber to get the callback thresold.
+func GetUSBVoltageCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetUSBVoltageCallbackThreshold"),
+		Fid:        function_get_usb_voltage_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetUSBVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetUSBVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetUSBVoltageCallbackThreshold("getusbvoltagecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// StackCurrentReached creates a subscriber for the threshold triggered stack current callback.
+func StackCurrentReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StackCurrentReached"),
+		Fid:        callback_stack_current_reached,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// StackVoltageReached creates a subscriber for the threshold triggered stack voltage callback.
+func StackVoltageReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StackVoltageReached"),
+		Fid:        callback_stack_voltage_reached,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// USBVoltageReached creates a subscriber for the threshold triggered USB voltage callback.
+func USBVoltageReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "USBVoltageReached"),
+		Fid:        callback_usb_voltage_reached,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,84 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetStackVoltage creates a subscriber to get the stack voltage (mV).
+// The stack voltage is the voltage of a power supply, if no power supply is connected, the value is 0.
+func GetStackVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStackVoltage"),
+		Fid:        function_get_stack_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStackVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStackVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetStackVoltage("getstackvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// GetUSBVoltage creates a subscriber to get the USB voltage (mV).
+func GetUSBVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetUSBVoltage"),
+		Fid:        function_get_usb_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetUSBVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetUSBVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetUSBVoltage("getusbvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// Voltage result type.
+type Voltage struct {
+	Value uint16 // mV
+}
+
+// FromPacket creates from a packet a Voltage.
+func (v *Voltage) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Voltage) String() string {
+	txt := "Voltage "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mV]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Voltage) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Voltage{Value: v.Value}
+}
+
+// Float64 converts the voltage value (mV) into V.
+func (v *Voltage) Float64() float64 {
+	return float64(v.Value) / 1000.0
+}
@@ -0,0 +1,675 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+	"net"
+)
+
+// SetWifiConfiguration creates the subscriber to set the configuration of the WIFI extension.
+// The configuration is written into the EEPROM of the WIFI extension,
+// it is only used after the master brick is restarted (see Reset).
+func SetWifiConfiguration(id string, uid uint32, c *WifiConfiguration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiConfiguration"),
+		Fid:        function_set_wifi_configuration,
+		Uid:        uid,
+		Data:       NewWifiConfigurationRaw(c),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *WifiConfiguration) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiConfiguration("setwificonfigurationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetWifiConfiguration creates the subscriber to get the configuration of the WIFI extension.
+func GetWifiConfiguration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiConfiguration"),
+		Fid:        function_get_wifi_configuration,
+		Uid:        uid,
+		Result:     &WifiConfiguration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*WifiConfiguration, error) {
+	return device.FutureOf[*WifiConfiguration](brick, connectorname, GetWifiConfiguration("getwificonfigurationfuture"+device.GenId(), uid, nil))
+}
+
+// SetWifiEncryption creates the subscriber to set the encryption of the WIFI extension.
+// A key with more than 50 characters must be set with SetLongWifiKey, the key of the encryption should be "-".
+// The encryption is only used after the master brick is restarted (see Reset).
+func SetWifiEncryption(id string, uid uint32, e *WifiEncryption, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiEncryption"),
+		Fid:        function_set_wifi_encryption,
+		Uid:        uid,
+		Data:       NewWifiEncryptionRaw(e),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiEncryptionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiEncryptionFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *WifiEncryption) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiEncryption("setwifiencryptionfuture"+device.GenId(), uid, e, nil))
+}
+
+// GetWifiEncryption creates the subscriber to get the encryption of the WIFI extension.
+// For security reasons the key is not returned.
+func GetWifiEncryption(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiEncryption"),
+		Fid:        function_get_wifi_encryption,
+		Uid:        uid,
+		Result:     &WifiEncryption{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiEncryptionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiEncryptionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*WifiEncryption, error) {
+	return device.FutureOf[*WifiEncryption](brick, connectorname, GetWifiEncryption("getwifiencryptionfuture"+device.GenId(), uid, nil))
+}
+
+// SetLongWifiKey creates the subscriber to set a WPA key with up to 64 characters.
+func SetLongWifiKey(id string, uid uint32, s *Secret, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetLongWifiKey"),
+		Fid:        function_set_long_wifi_key,
+		Uid:        uid,
+		Data:       NewSecretRaw(s),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetLongWifiKeyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetLongWifiKeyFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *Secret) error {
+	return device.FutureEmpty(brick, connectorname, SetLongWifiKey("setlongwifikeyfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetLongWifiKey creates the subscriber to get the long WPA key.
+func GetLongWifiKey(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetLongWifiKey"),
+		Fid:        function_get_long_wifi_key,
+		Uid:        uid,
+		Result:     &Secret{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetLongWifiKeyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetLongWifiKeyFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Secret, error) {
+	return device.FutureOf[*Secret](brick, connectorname, GetLongWifiKey("getlongwifikeyfuture"+device.GenId(), uid, nil))
+}
+
+// GetWifiStatus creates the subscriber to get the status of the WIFI extension.
+// The status is updated every 5 seconds or with RefreshWifiStatus.
+func GetWifiStatus(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiStatus"),
+		Fid:        function_get_wifi_status,
+		Uid:        uid,
+		Result:     &WifiStatus{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiStatusFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiStatusFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*WifiStatus, error) {
+	return device.FutureOf[*WifiStatus](brick, connectorname, GetWifiStatus("getwifistatusfuture"+device.GenId(), uid, nil))
+}
+
+// RefreshWifiStatus creates the subscriber to refresh the status of the WIFI extension.
+// The refresh needs up to 500ms.
+func RefreshWifiStatus(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "RefreshWifiStatus"),
+		Fid:        function_refresh_wifi_status,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// RefreshWifiStatusFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func RefreshWifiStatusFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, RefreshWifiStatus("refreshwifistatusfuture"+device.GenId(), uid, nil))
+}
+
+// SetWifiPowerMode creates the subscriber to set the power mode of the WIFI extension.
+// The default value is full speed, the low power mode has higher latencies.
+func SetWifiPowerMode(id string, uid uint32, m *PowerMode, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiPowerMode"),
+		Fid:        function_set_wifi_power_mode,
+		Uid:        uid,
+		Data:       m,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiPowerModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiPowerModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *PowerMode) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiPowerMode("setwifipowermodefuture"+device.GenId(), uid, m, nil))
+}
+
+// GetWifiPowerMode creates the subscriber to get the power mode of the WIFI extension.
+func GetWifiPowerMode(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiPowerMode"),
+		Fid:        function_get_wifi_power_mode,
+		Uid:        uid,
+		Result:     &PowerMode{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiPowerModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiPowerModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*PowerMode, error) {
+	return device.FutureOf[*PowerMode](brick, connectorname, GetWifiPowerMode("getwifipowermodefuture"+device.GenId(), uid, nil))
+}
+
+// GetWifiBufferInfo creates the subscriber to get informations about the buffer of the WIFI extension.
+// If the overflow counter grows, too much data is sent (e.g. too short callback periods).
+func GetWifiBufferInfo(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiBufferInfo"),
+		Fid:        function_get_wifi_buffer_info,
+		Uid:        uid,
+		Result:     &BufferInfo{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiBufferInfoFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiBufferInfoFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*BufferInfo, error) {
+	return device.FutureOf[*BufferInfo](brick, connectorname, GetWifiBufferInfo("getwifibufferinfofuture"+device.GenId(), uid, nil))
+}
+
+// SetWifiRegulatoryDomain creates the subscriber to set the regulatory domain of the WIFI extension.
+// The default value is ETSI (channel 1 to 13).
+func SetWifiRegulatoryDomain(id string, uid uint32, d *RegulatoryDomain, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiRegulatoryDomain"),
+		Fid:        function_set_wifi_regulatory_domain,
+		Uid:        uid,
+		Data:       d,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiRegulatoryDomainFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiRegulatoryDomainFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *RegulatoryDomain) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiRegulatoryDomain("setwifiregulatorydomainfuture"+device.GenId(), uid, d, nil))
+}
+
+// GetWifiRegulatoryDomain creates the subscriber to get the regulatory domain of the WIFI extension.
+func GetWifiRegulatoryDomain(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiRegulatoryDomain"),
+		Fid:        function_get_wifi_regulatory_domain,
+		Uid:        uid,
+		Result:     &RegulatoryDomain{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiRegulatoryDomainFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiRegulatoryDomainFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*RegulatoryDomain, error) {
+	return device.FutureOf[*RegulatoryDomain](brick, connectorname, GetWifiRegulatoryDomain("getwifiregulatorydomainfuture"+device.GenId(), uid, nil))
+}
+
+// SetWifiHostname creates the subscriber to set the hostname of the WIFI extension (up to 16 characters).
+// A empty hostname means the default hostname ("wifi-extension-v2").
+func SetWifiHostname(id string, uid uint32, h *Hostname, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiHostname"),
+		Fid:        function_set_wifi_hostname,
+		Uid:        uid,
+		Data:       NewWifiHostnameRaw(h),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiHostnameFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiHostnameFuture(brick *bricker.Bricker, connectorname string, uid uint32, h *Hostname) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiHostname("setwifihostnamefuture"+device.GenId(), uid, h, nil))
+}
+
+// GetWifiHostname creates the subscriber to get the hostname of the WIFI extension.
+func GetWifiHostname(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiHostname"),
+		Fid:        function_get_wifi_hostname,
+		Uid:        uid,
+		Result:     &Hostname{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiHostnameFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiHostnameFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Hostname, error) {
+	return device.FutureOf[*Hostname](brick, connectorname, GetWifiHostname("getwifihostnamefuture"+device.GenId(), uid, nil))
+}
+
+// SetWifiAuthenticationSecret creates the subscriber to set the authentication secret of the WIFI extension (up to 64 characters).
+// A empty secret disables the authentication.
+func SetWifiAuthenticationSecret(id string, uid uint32, s *Secret, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWifiAuthenticationSecret"),
+		Fid:        function_set_wifi_authentication_secret,
+		Uid:        uid,
+		Data:       NewSecretRaw(s),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWifiAuthenticationSecretFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWifiAuthenticationSecretFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *Secret) error {
+	return device.FutureEmpty(brick, connectorname, SetWifiAuthenticationSecret("setwifiauthenticationsecretfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetWifiAuthenticationSecret creates the subscriber to get the authentication secret of the WIFI extension.
+func GetWifiAuthenticationSecret(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWifiAuthenticationSecret"),
+		Fid:        function_get_wifi_authentication_secret,
+		Uid:        uid,
+		Result:     &Secret{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWifiAuthenticationSecretFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWifiAuthenticationSecretFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Secret, error) {
+	return device.FutureOf[*Secret](brick, connectorname, GetWifiAuthenticationSecret("getwifiauthenticationsecretfuture"+device.GenId(), uid, nil))
+}
+
+// WifiConfiguration is the configuration of the WIFI extension.
+// The addresses are only used with a static IP connection.
+type WifiConfiguration struct {
+	Ssid       string // up to 32 characters
+	Connection uint8  // WifiConnection constant
+	Ip         net.IP
+	SubnetMask net.IP
+	Gateway    net.IP
+	Port       uint16 // default 4223
+}
+
+// FromPacket converts the packet payload to the WifiConfiguration type.
+func (c *WifiConfiguration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	cr := new(WifiConfigurationRaw)
+	err := p.Payload.Decode(cr)
+	if err == nil {
+		c.FromWifiConfigurationRaw(cr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (c *WifiConfiguration) String() string {
+	txt := "WIFI Configuration "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[SSID: %s, Connection: %s (%d), IP: %s, Subnet Mask: %s, Gateway: %s, Port: %d]",
+			c.Ssid, WifiConnectionName(c.Connection), c.Connection, c.Ip, c.SubnetMask, c.Gateway, c.Port)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *WifiConfiguration) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &WifiConfiguration{
+		Ssid:       c.Ssid,
+		Connection: c.Connection,
+		Ip:         copyIP(c.Ip),
+		SubnetMask: copyIP(c.SubnetMask),
+		Gateway:    copyIP(c.Gateway),
+		Port:       c.Port}
+}
+
+// FromWifiConfigurationRaw converts a WifiConfigurationRaw into a WifiConfiguration.
+func (c *WifiConfiguration) FromWifiConfigurationRaw(cr *WifiConfigurationRaw) {
+	if c == nil || cr == nil {
+		return
+	}
+	c.Ssid = misc.BytesToString(cr.Ssid[:])
+	c.Connection = cr.Connection
+	c.Ip = IPFromRaw(cr.Ip)
+	c.SubnetMask = IPFromRaw(cr.SubnetMask)
+	c.Gateway = IPFromRaw(cr.Gateway)
+	c.Port = cr.Port
+}
+
+// WifiConfigurationRaw is the de/encoding type for WifiConfiguration.
+type WifiConfigurationRaw struct {
+	Ssid       [32]byte
+	Connection uint8
+	Ip         [4]uint8
+	SubnetMask [4]uint8
+	Gateway    [4]uint8
+	Port       uint16
+}
+
+// NewWifiConfigurationRaw creates a WifiConfigurationRaw from a WifiConfiguration.
+func NewWifiConfigurationRaw(c *WifiConfiguration) *WifiConfigurationRaw {
+	if c == nil {
+		return nil
+	}
+	cr := &WifiConfigurationRaw{
+		Connection: c.Connection,
+		Ip:         IPToRaw(c.Ip),
+		SubnetMask: IPToRaw(c.SubnetMask),
+		Gateway:    IPToRaw(c.Gateway),
+		Port:       c.Port}
+	copy(cr.Ssid[:], c.Ssid)
+	return cr
+}
+
+// WifiEncryption is the encryption of the WIFI extension.
+// The key index is only used with WEP (1 to 4), the eap options and the certificate lengths
+// only with WPA Enterprise.
+type WifiEncryption struct {
+	Encryption              uint8  // WifiEncryption constant
+	Key                     string // up to 50 characters, longer keys with SetLongWifiKey
+	KeyIndex                uint8
+	EapOptions              uint8
+	CaCertificateLength     uint16
+	ClientCertificateLength uint16
+	PrivateKeyLength        uint16
+}
+
+// FromPacket converts the packet payload to the WifiEncryption type.
+// The key inside the packet of the getter is blanked, so the key of the result is empty.
+func (e *WifiEncryption) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(WifiEncryptionRaw)
+	err := p.Payload.Decode(er)
+	if err == nil {
+		e.FromWifiEncryptionRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+// The key is not shown.
+func (e *WifiEncryption) String() string {
+	txt := "WIFI Encryption "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Encryption: %s (%d), Key Index: %d, EAP Options: %d, "+
+			"CA Certificate Length: %d, Client Certificate Length: %d, Private Key Length: %d]",
+			WifiEncryptionName(e.Encryption), e.Encryption, e.KeyIndex, e.EapOptions,
+			e.CaCertificateLength, e.ClientCertificateLength, e.PrivateKeyLength)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *WifiEncryption) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	return &c
+}
+
+// WifiEncryptionRaw is the de/encoding type for WifiEncryption.
+type WifiEncryptionRaw struct {
+	Encryption              uint8
+	Key                     [50]byte
+	KeyIndex                uint8
+	EapOptions              uint8
+	CaCertificateLength     uint16
+	ClientCertificateLength uint16
+	PrivateKeyLength        uint16
+}
+
+// FromWifiEncryptionRaw converts a WifiEncryptionRaw into a WifiEncryption.
+// The key is dropped, the brick answers only with a blanked key.
+func (e *WifiEncryption) FromWifiEncryptionRaw(er *WifiEncryptionRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Encryption = er.Encryption
+	e.Key = ""
+	e.KeyIndex = er.KeyIndex
+	e.EapOptions = er.EapOptions
+	e.CaCertificateLength = er.CaCertificateLength
+	e.ClientCertificateLength = er.ClientCertificateLength
+	e.PrivateKeyLength = er.PrivateKeyLength
+}
+
+// NewWifiEncryptionRaw creates a WifiEncryptionRaw from a WifiEncryption.
+func NewWifiEncryptionRaw(e *WifiEncryption) *WifiEncryptionRaw {
+	if e == nil {
+		return nil
+	}
+	er := &WifiEncryptionRaw{
+		Encryption:              e.Encryption,
+		KeyIndex:                e.KeyIndex,
+		EapOptions:              e.EapOptions,
+		CaCertificateLength:     e.CaCertificateLength,
+		ClientCertificateLength: e.ClientCertificateLength,
+		PrivateKeyLength:        e.PrivateKeyLength}
+	copy(er.Key[:], e.Key)
+	return er
+}
+
+// WifiStatus is the status of the WIFI extension.
+type WifiStatus struct {
+	MacAddress net.HardwareAddr
+	Bssid      net.HardwareAddr
+	Channel    uint8
+	Rssi       int16
+	Ip         net.IP
+	SubnetMask net.IP
+	Gateway    net.IP
+	RxCount    uint32
+	TxCount    uint32
+	State      uint8 // WifiState constant
+}
+
+// FromPacket converts the packet payload to the WifiStatus type.
+func (s *WifiStatus) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	sr := new(WifiStatusRaw)
+	err := p.Payload.Decode(sr)
+	if err == nil {
+		s.MacAddress = MACFromRaw(sr.MacAddress)
+		s.Bssid = MACFromRaw(sr.Bssid)
+		s.Channel = sr.Channel
+		s.Rssi = sr.Rssi
+		s.Ip = IPFromRaw(sr.Ip)
+		s.SubnetMask = IPFromRaw(sr.SubnetMask)
+		s.Gateway = IPFromRaw(sr.Gateway)
+		s.RxCount = sr.RxCount
+		s.TxCount = sr.TxCount
+		s.State = sr.State
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (s *WifiStatus) String() string {
+	txt := "WIFI Status "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[MAC Address: %s, BSSID: %s, Channel: %d, RSSI: %d, IP: %s, Subnet Mask: %s, "+
+			"Gateway: %s, RX Count: %d, TX Count: %d, State: %s (%d)]",
+			s.MacAddress, s.Bssid, s.Channel, s.Rssi, s.Ip, s.SubnetMask,
+			s.Gateway, s.RxCount, s.TxCount, WifiStateName(s.State), s.State)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *WifiStatus) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &WifiStatus{
+		MacAddress: copyMAC(s.MacAddress),
+		Bssid:      copyMAC(s.Bssid),
+		Channel:    s.Channel,
+		Rssi:       s.Rssi,
+		Ip:         copyIP(s.Ip),
+		SubnetMask: copyIP(s.SubnetMask),
+		Gateway:    copyIP(s.Gateway),
+		RxCount:    s.RxCount,
+		TxCount:    s.TxCount,
+		State:      s.State}
+}
+
+// WifiStatusRaw is the decoding type for WifiStatus.
+type WifiStatusRaw struct {
+	MacAddress [6]uint8
+	Bssid      [6]uint8
+	Channel    uint8
+	Rssi       int16
+	Ip         [4]uint8
+	SubnetMask [4]uint8
+	Gateway    [4]uint8
+	RxCount    uint32
+	TxCount    uint32
+	State      uint8
+}
+
+// PowerMode is the power mode of the WIFI extension.
+type PowerMode struct {
+	Value uint8 // WifiPowerMode constant
+}
+
+// FromPacket creates from a packet a PowerMode.
+func (m *PowerMode) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(m, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(m)
+}
+
+// String fullfill the stringer interface.
+func (m *PowerMode) String() string {
+	txt := "Power Mode "
+	if m == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", m.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (m *PowerMode) Copy() device.Resulter {
+	if m == nil {
+		return nil
+	}
+	return &PowerMode{Value: m.Value}
+}
+
+// RegulatoryDomain is the regulatory domain of the WIFI extension.
+type RegulatoryDomain struct {
+	Value uint8 // WifiDomain constant
+}
+
+// FromPacket creates from a packet a RegulatoryDomain.
+func (d *RegulatoryDomain) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *RegulatoryDomain) String() string {
+	txt := "Regulatory Domain "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", d.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *RegulatoryDomain) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &RegulatoryDomain{Value: d.Value}
+}
+
+// BufferInfo is the information about the buffer of the WIFI extension.
+type BufferInfo struct {
+	Overflow     uint32 // number of lost packets
+	LowWatermark uint16 // minimal free buffer since the start (bytes)
+	Used         uint16 // used buffer (bytes)
+}
+
+// FromPacket creates from a packet a BufferInfo.
+func (b *BufferInfo) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(b, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(b)
+}
+
+// String fullfill the stringer interface.
+func (b *BufferInfo) String() string {
+	txt := "Buffer Info "
+	if b == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Overflow: %d, Low Watermark: %d, Used: %d]", b.Overflow, b.LowWatermark, b.Used)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (b *BufferInfo) Copy() device.Resulter {
+	if b == nil {
+		return nil
+	}
+	return &BufferInfo{Overflow: b.Overflow, LowWatermark: b.LowWatermark, Used: b.Used}
+}
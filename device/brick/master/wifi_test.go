@@ -0,0 +1,89 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package master
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestWifiConfiguration(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	c := &WifiConfiguration{
+		Ssid:       "tinkerforge",
+		Connection: WifiConnectionStaticIP,
+		Ip:         net.ParseIP("192.168.0.20"),
+		SubnetMask: net.ParseIP("255.255.255.0"),
+		Gateway:    net.ParseIP("192.168.0.1"),
+		Port:       4223}
+	if err := SetWifiConfigurationFuture(b, "virtual", uid, c); err != nil {
+		t.Fatalf("Error TestWifiConfiguration: SetWifiConfiguration failed (%s).", err)
+	}
+	want := make([]byte, 32)
+	copy(want, "tinkerforge")
+	want = append(want, WifiConnectionStaticIP, 20, 0, 168, 192, 0, 255, 255, 255, 1, 0, 168, 192, 0x7f, 0x10)
+	if r := f.Request(function_set_wifi_configuration); !bytes.Equal(r, want) {
+		t.Fatalf("Error TestWifiConfiguration: wrong payload % x.", r)
+	}
+	r, err := GetWifiConfigurationFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestWifiConfiguration: GetWifiConfiguration failed (%s).", err)
+	}
+	if r.Ssid != c.Ssid || r.Connection != c.Connection || !r.Ip.Equal(c.Ip) ||
+		!r.SubnetMask.Equal(c.SubnetMask) || !r.Gateway.Equal(c.Gateway) || r.Port != c.Port {
+		t.Fatalf("Error TestWifiConfiguration: wrong configuration %s.", r)
+	}
+}
+
+func TestWifiEncryption(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	e := &WifiEncryption{Encryption: WifiEncryptionWEP, Key: "secret", KeyIndex: 2}
+	if err := SetWifiEncryptionFuture(b, "virtual", uid, e); err != nil {
+		t.Fatalf("Error TestWifiEncryption: SetWifiEncryption failed (%s).", err)
+	}
+	req := f.Request(function_set_wifi_encryption)
+	if len(req) != 59 || req[0] != WifiEncryptionWEP || string(req[1:7]) != "secret" || req[51] != 2 {
+		t.Fatalf("Error TestWifiEncryption: wrong payload % x.", req)
+	}
+	// response of the brick: the key is blanked
+	resp := []byte{WifiEncryptionWPAEnterprise}
+	resp = append(resp, bytes.Repeat([]byte{'-'}, 50)...)
+	resp = append(resp, 1, 0x0a, 0xd2, 0x04, 0x29, 0x09, 0x59, 0x01)
+	f.Respond(function_get_wifi_encryption, resp)
+	r, err := GetWifiEncryptionFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestWifiEncryption: GetWifiEncryption failed (%s).", err)
+	}
+	want := WifiEncryption{Encryption: WifiEncryptionWPAEnterprise, KeyIndex: 1, EapOptions: 0x0a,
+		CaCertificateLength: 1234, ClientCertificateLength: 2345, PrivateKeyLength: 345}
+	if *r != want {
+		t.Fatalf("Error TestWifiEncryption: wrong encryption %s.", r)
+	}
+}
+
+func TestWifiStatus(t *testing.T) {
+	b, f := newMaster(t)
+	defer f.Done()
+	f.Respond(function_get_wifi_status, []byte{
+		0x1f, 0xa2, 0x04, 0x55, 0xd8, 0x40, // MAC address
+		0x66, 0x55, 0x44, 0x33, 0x22, 0x11, // BSSID
+		6, 0xc4, 0xff, // channel, RSSI
+		20, 0, 168, 192, 0, 255, 255, 255, 1, 0, 168, 192, // IP, subnet mask, gateway
+		0x39, 0x30, 0, 0, 0x31, 0xd4, 0, 0, // RX count, TX count
+		WifiStateAssociated})
+	s, err := GetWifiStatusFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestWifiStatus: GetWifiStatus failed (%s).", err)
+	}
+	if s.MacAddress.String() != "40:d8:55:04:a2:1f" || s.Bssid.String() != "11:22:33:44:55:66" ||
+		s.Channel != 6 || s.Rssi != -60 || s.Ip.String() != "192.168.0.20" ||
+		s.SubnetMask.String() != "255.255.255.0" || s.Gateway.String() != "192.168.0.1" ||
+		s.RxCount != 12345 || s.TxCount != 54321 || s.State != WifiStateAssociated {
+		t.Fatalf("Error TestWifiStatus: wrong status %s.", s)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetAcceleration creates the subscriber to set the acceleration of a servo or a selection of servos.
+// The acceleration is given in °/100s² and is used for acceleration and deceleration.
+// The maximal value 65535 means no acceleration limit.
+func SetAcceleration(id string, uid uint32, sa *ServoAcceleration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAcceleration"),
+		Fid:        function_set_acceleration,
+		Uid:        uid,
+		Data:       sa,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAccelerationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAccelerationFuture(brick *bricker.Bricker, connectorname string, uid uint32, sa *ServoAcceleration) error {
+	return device.FutureEmpty(brick, connectorname, SetAcceleration("setaccelerationfuture"+device.GenId(), uid, sa, nil))
+}
+
+// GetAcceleration creates the subscriber to get the acceleration of a servo, which was set with SetAcceleration.
+func GetAcceleration(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAcceleration"),
+		Fid:        function_get_acceleration,
+		Uid:        uid,
+		Result:     &Acceleration{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAccelerationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAccelerationFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Acceleration, error) {
+	return device.FutureOf[*Acceleration](brick, connectorname, GetAcceleration("getaccelerationfuture"+device.GenId(), uid, s, nil))
+}
+
+// ServoAcceleration is the type to set the acceleration of a servo or a selection of servos.
+type ServoAcceleration struct {
+	Servo        Servo
+	Acceleration uint16 // °/100s²
+}
+
+// Acceleration is the acceleration of a servo in °/100s².
+type Acceleration struct {
+	Value uint16 // °/100s²
+}
+
+// FromPacket creates from a packet a Acceleration.
+func (a *Acceleration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *Acceleration) String() string {
+	txt := "Acceleration "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d °/100s²]", a.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Acceleration) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Acceleration{Value: a.Value}
+}
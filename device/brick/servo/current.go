@@ -0,0 +1,79 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetServoCurrent creates the subscriber to get the current consumption of a servo in mA.
+func GetServoCurrent(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetServoCurrent"),
+		Fid:        function_get_servo_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetServoCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetServoCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetServoCurrent("getservocurrentfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetOverallCurrent creates the subscriber to get the current consumption of all servos together in mA.
+func GetOverallCurrent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetOverallCurrent"),
+		Fid:        function_get_overall_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetOverallCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetOverallCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetOverallCurrent("getoverallcurrentfuture"+device.GenId(), uid, nil))
+}
+
+// Current is the current consumption in mA.
+type Current struct {
+	Value uint16 // mA
+}
+
+// FromPacket creates from a packet a Current.
+func (c *Current) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Current) String() string {
+	txt := "Current "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mA]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Current) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Current{Value: c.Value}
+}
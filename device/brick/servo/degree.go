@@ -0,0 +1,90 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetDegree creates the subscriber to set the minimal and maximal degree of a servo or a selection of servos.
+// The degree is given in °/100 and defines the unit of the position, velocity and acceleration.
+// The minimal degree maps to the minimal pulse width, the maximal degree to the maximal pulse width.
+// The default is -9000 to 9000 (-90° to 90°).
+func SetDegree(id string, uid uint32, sd *ServoDegree, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDegree"),
+		Fid:        function_set_degree,
+		Uid:        uid,
+		Data:       sd,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDegreeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDegreeFuture(brick *bricker.Bricker, connectorname string, uid uint32, sd *ServoDegree) error {
+	return device.FutureEmpty(brick, connectorname, SetDegree("setdegreefuture"+device.GenId(), uid, sd, nil))
+}
+
+// GetDegree creates the subscriber to get the degree range of a servo, which was set with SetDegree.
+func GetDegree(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDegree"),
+		Fid:        function_get_degree,
+		Uid:        uid,
+		Result:     &Degree{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDegreeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDegreeFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Degree, error) {
+	return device.FutureOf[*Degree](brick, connectorname, GetDegree("getdegreefuture"+device.GenId(), uid, s, nil))
+}
+
+// ServoDegree is the type to set the degree range of a servo or a selection of servos.
+type ServoDegree struct {
+	Servo Servo
+	Min   int16 // °/100
+	Max   int16 // °/100
+}
+
+// Degree is the minimal and maximal degree of a servo in °/100.
+type Degree struct {
+	Min int16 // °/100
+	Max int16 // °/100
+}
+
+// FromPacket creates from a packet a Degree.
+func (d *Degree) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *Degree) String() string {
+	txt := "Degree "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Min: %d °/100, Max: %d °/100]", d.Min, d.Max)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *Degree) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &Degree{Min: d.Min, Max: d.Max}
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// Enable creates the subscriber to enable a servo or a selection of servos.
+// The configuration of the servo (position, velocity, ...) should be done before enabling.
+func Enable(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Enable"),
+		Fid:        function_enable,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnableFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) error {
+	return device.FutureEmpty(brick, connectorname, Enable("enablefuture"+device.GenId(), uid, s, nil))
+}
+
+// Disable creates the subscriber to disable a servo or a selection of servos.
+// A disabled servo has no PWM output, it could be moved by hand.
+func Disable(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Disable"),
+		Fid:        function_disable,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisableFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) error {
+	return device.FutureEmpty(brick, connectorname, Disable("disablefuture"+device.GenId(), uid, s, nil))
+}
+
+// IsEnabled creates the subscriber to get the enabled state of a servo.
+func IsEnabled(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsEnabled"),
+		Fid:        function_is_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsEnabled("isenabledfuture"+device.GenId(), uid, s, nil))
+}
+
+// IsEnabledFutureSimple calls the IsEnabledFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsEnabledFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (bool, error) {
+	e, err := IsEnabledFuture(brick, connectorname, uid, s)
+	if err != nil {
+		return false, err
+	}
+	return e.Value, nil
+}
+
+// Enabled is a type for showing if a servo or a callback is enabled or disabled.
+type Enabled struct {
+	Value bool // true - enabled, false - disabled
+}
+
+// FromPacket converts the packet payload to the Enabled type.
+func (e *Enabled) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(EnabledRaw)
+	err := p.Payload.Decode(er)
+	if err == nil && er != nil {
+		e.FromEnabledRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (e *Enabled) String() string {
+	txt := "Enabled "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", e.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Enabled) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Enabled{Value: e.Value}
+}
+
+// FromEnabledRaw converts the EnabledRaw into a Enabled.
+func (e *Enabled) FromEnabledRaw(er *EnabledRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Value = misc.Uint8ToBool(er.Value)
+}
+
+// EnabledRaw is the real de/encoding type for a Enabled.
+type EnabledRaw struct {
+	Value uint8
+}
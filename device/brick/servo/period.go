@@ -0,0 +1,86 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetPeriod creates the subscriber to set the period of the PWM signal of a servo or a selection of servos.
+// The period is given in µs (1-65535), the usual period of a servo is 20ms (20000µs).
+func SetPeriod(id string, uid uint32, sp *ServoPeriod, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPeriod"),
+		Fid:        function_set_period,
+		Uid:        uid,
+		Data:       sp,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, sp *ServoPeriod) error {
+	return device.FutureEmpty(brick, connectorname, SetPeriod("setperiodfuture"+device.GenId(), uid, sp, nil))
+}
+
+// GetPeriod creates the subscriber to get the PWM period of a servo, which was set with SetPeriod.
+func GetPeriod(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPeriod"),
+		Fid:        function_get_period,
+		Uid:        uid,
+		Result:     &Period{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Period, error) {
+	return device.FutureOf[*Period](brick, connectorname, GetPeriod("getperiodfuture"+device.GenId(), uid, s, nil))
+}
+
+// ServoPeriod is the type to set the PWM period of a servo or a selection of servos.
+type ServoPeriod struct {
+	Servo  Servo
+	Period uint16 // µs
+}
+
+// Period is the period of the PWM signal of a servo in µs.
+type Period struct {
+	Value uint16 // µs
+}
+
+// FromPacket creates from a packet a Period.
+func (pe *Period) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(pe, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(pe)
+}
+
+// String fullfill the stringer interface.
+func (pe *Period) String() string {
+	txt := "Period "
+	if pe == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d µs]", pe.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (pe *Period) Copy() device.Resulter {
+	if pe == nil {
+		return nil
+	}
+	return &Period{Value: pe.Value}
+}
@@ -0,0 +1,198 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetPosition creates the subscriber to set the position of a servo or a selection of servos.
+// The position is given in °/100 and must be between the minimal and maximal degree (see SetDegree).
+// The servo moves with the configured velocity and acceleration to the position.
+func SetPosition(id string, uid uint32, sp *ServoPosition, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPosition"),
+		Fid:        function_set_position,
+		Uid:        uid,
+		Data:       sp,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32, sp *ServoPosition) error {
+	return device.FutureEmpty(brick, connectorname, SetPosition("setpositionfuture"+device.GenId(), uid, sp, nil))
+}
+
+// GetPosition creates the subscriber to get the position of a servo, which was set with SetPosition.
+func GetPosition(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPosition"),
+		Fid:        function_get_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetPosition("getpositionfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetCurrentPosition creates the subscriber to get the current position of a servo.
+// The value is between the last set position and the new position, while the servo moves.
+func GetCurrentPosition(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentPosition"),
+		Fid:        function_get_current_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetCurrentPosition("getcurrentpositionfuture"+device.GenId(), uid, s, nil))
+}
+
+// PositionReached creates the subscriber for the position reached callback.
+// The callback is triggered, if a position set by SetPosition is reached.
+// The callback must be enabled with EnablePositionReachedCallback.
+// The reached position is only calculated by the brick, the real servo may not yet reached it.
+func PositionReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "PositionReached"),
+		Fid:        callback_position_reached,
+		Uid:        uid,
+		Result:     &ServoPosition{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// EnablePositionReachedCallback creates the subscriber to enable the PositionReached callback.
+func EnablePositionReachedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "EnablePositionReachedCallback"),
+		Fid:        function_enable_position_reached_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnablePositionReachedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnablePositionReachedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, EnablePositionReachedCallback("enablepositionreachedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// DisablePositionReachedCallback creates the subscriber to disable the PositionReached callback.
+func DisablePositionReachedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DisablePositionReachedCallback"),
+		Fid:        function_disable_position_reached_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisablePositionReachedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisablePositionReachedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DisablePositionReachedCallback("disablepositionreachedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// IsPositionReachedCallbackEnabled creates the subscriber to get the state of the PositionReached callback.
+func IsPositionReachedCallbackEnabled(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsPositionReachedCallbackEnabled"),
+		Fid:        function_is_position_reached_callback_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsPositionReachedCallbackEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsPositionReachedCallbackEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsPositionReachedCallbackEnabled("ispositionreachedcallbackenabledfuture"+device.GenId(), uid, nil))
+}
+
+// ServoPosition is the type to set the position of a servo or a selection of servos.
+// It is also the result of the PositionReached callback.
+type ServoPosition struct {
+	Servo    Servo
+	Position int16 // °/100
+}
+
+// FromPacket creates from a packet a ServoPosition.
+func (s *ServoPosition) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *ServoPosition) String() string {
+	txt := "Servo position "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Servo: %s, Position: %d °/100]", s.Servo, s.Position)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *ServoPosition) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &ServoPosition{Servo: s.Servo, Position: s.Position}
+}
+
+// Position is the position of a servo in °/100.
+type Position struct {
+	Value int16 // °/100
+}
+
+// FromPacket creates from a packet a Position.
+func (po *Position) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(po, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(po)
+}
+
+// String fullfill the stringer interface.
+func (po *Position) String() string {
+	txt := "Position "
+	if po == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d °/100]", po.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (po *Position) Copy() device.Resulter {
+	if po == nil {
+		return nil
+	}
+	return &Position{Value: po.Value}
+}
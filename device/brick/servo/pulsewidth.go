@@ -0,0 +1,89 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetPulseWidth creates the subscriber to set the minimal and maximal pulse width of a servo or a selection of servos.
+// The pulse width is given in µs, the values should be taken from the data sheet of the servo.
+// Typical values are 1000µs (minimal) and 2000µs (maximal).
+func SetPulseWidth(id string, uid uint32, sp *ServoPulseWidth, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPulseWidth"),
+		Fid:        function_set_pulse_width,
+		Uid:        uid,
+		Data:       sp,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPulseWidthFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPulseWidthFuture(brick *bricker.Bricker, connectorname string, uid uint32, sp *ServoPulseWidth) error {
+	return device.FutureEmpty(brick, connectorname, SetPulseWidth("setpulsewidthfuture"+device.GenId(), uid, sp, nil))
+}
+
+// GetPulseWidth creates the subscriber to get the pulse width of a servo, which was set with SetPulseWidth.
+func GetPulseWidth(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPulseWidth"),
+		Fid:        function_get_pulse_width,
+		Uid:        uid,
+		Result:     &PulseWidth{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPulseWidthFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPulseWidthFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*PulseWidth, error) {
+	return device.FutureOf[*PulseWidth](brick, connectorname, GetPulseWidth("getpulsewidthfuture"+device.GenId(), uid, s, nil))
+}
+
+// ServoPulseWidth is the type to set the pulse width of a servo or a selection of servos.
+type ServoPulseWidth struct {
+	Servo Servo
+	Min   uint16 // µs
+	Max   uint16 // µs
+}
+
+// PulseWidth is the minimal and maximal pulse width of a servo in µs.
+type PulseWidth struct {
+	Min uint16 // µs
+	Max uint16 // µs
+}
+
+// FromPacket creates from a packet a PulseWidth.
+func (pw *PulseWidth) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(pw, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(pw)
+}
+
+// String fullfill the stringer interface.
+func (pw *PulseWidth) String() string {
+	txt := "Pulse width "
+	if pw == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Min: %d µs, Max: %d µs]", pw.Min, pw.Max)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (pw *PulseWidth) Copy() device.Resulter {
+	if pw == nil {
+		return nil
+	}
+	return &PulseWidth{Min: pw.Min, Max: pw.Max}
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Servo Brick.
+package servo
+
+import (
+	"fmt"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+const (
+	function_enable                               = uint8(1)
+	function_disable                              = uint8(2)
+	function_is_enabled                           = uint8(3)
+	function_set_position                         = uint8(4)
+	function_get_position                         = uint8(5)
+	function_get_current_position                 = uint8(6)
+	function_set_velocity                         = uint8(7)
+	function_get_velocity                         = uint8(8)
+	function_get_current_velocity                 = uint8(9)
+	function_set_acceleration                     = uint8(10)
+	function_get_acceleration                     = uint8(11)
+	function_set_output_voltage                   = uint8(12)
+	function_get_output_voltage                   = uint8(13)
+	function_set_pulse_width                      = uint8(14)
+	function_get_pulse_width                      = uint8(15)
+	function_set_degree                           = uint8(16)
+	function_get_degree                           = uint8(17)
+	function_set_period                           = uint8(18)
+	function_get_period                           = uint8(19)
+	function_get_servo_current                    = uint8(20)
+	function_get_overall_current                  = uint8(21)
+	function_get_stack_input_voltage              = uint8(22)
+	function_get_external_input_voltage           = uint8(23)
+	function_set_minimum_voltage                  = uint8(24)
+	function_get_minimum_voltage                  = uint8(25)
+	function_enable_position_reached_callback     = uint8(29)
+	function_disable_position_reached_callback    = uint8(30)
+	function_is_position_reached_callback_enabled = uint8(31)
+	function_enable_velocity_reached_callback     = uint8(32)
+	function_disable_velocity_reached_callback    = uint8(33)
+	function_is_velocity_reached_callback_enabled = uint8(34)
+	function_get_chip_temperature                 = uint8(242)
+	function_reset                                = uint8(243)
+	callback_under_voltage                        = uint8(26)
+	callback_position_reached                     = uint8(27)
+	callback_velocity_reached                     = uint8(28)
+	// Servos
+	ServoCount     = uint8(7)    // number of servos of the brick
+	ServoSelection = Servo(0x80) // highest bit marks a selection of servos
+	ServoAll       = Servo(0xff) // selection of all servos
+)
+
+/*
+Servo is the number of a servo (0-6) or a selection of servos.
+
+If the highest bit is set, the lower 7 bits are a bitmask of the selected servos.
+A selection could only used for setting values, all getter need a single servo.
+
+	Servo(2)          - only servo 2
+	Select(0, 5)      - servo 0 and 5 (0xa1)
+	ServoAll          - all servos (0xff)
+*/
+type Servo uint8
+
+// Select creates a selection of the given servos.
+// Servo numbers greater than 6 are ignored.
+func Select(servos ...uint8) Servo {
+	return ServoSelection | Servo(misc.BitsToMask(servos...)&0x7f)
+}
+
+// IsSelection tests, if the servo is a selection of servos.
+func (s Servo) IsSelection() bool {
+	return s&ServoSelection == ServoSelection
+}
+
+// Servos gives the numbers of all servos, which are selected.
+func (s Servo) Servos() []uint8 {
+	if !s.IsSelection() {
+		if uint8(s) < ServoCount {
+			return []uint8{uint8(s)}
+		}
+		return []uint8{}
+	}
+	return misc.MaskToBits(uint8(s), ServoCount)
+}
+
+// String fullfill the stringer interface.
+func (s Servo) String() string {
+	if s.IsSelection() {
+		return fmt.Sprintf("Selection %s", misc.MaskToString(uint8(s), ServoCount, false))
+	}
+	return fmt.Sprintf("%d", uint8(s))
+}
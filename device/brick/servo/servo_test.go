@@ -0,0 +1,61 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"bytes"
+	"github.com/dirkjabl/bricker/util/fakedevice"
+	"reflect"
+	"testing"
+)
+
+const uid = uint32(4711)
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		servo     Servo
+		selection bool
+		servos    []uint8
+		txt       string
+	}{{servo: Servo(2), selection: false, servos: []uint8{2}, txt: "2"},
+		{servo: Servo(7), selection: false, servos: []uint8{}, txt: "7"},
+		{servo: Select(), selection: true, servos: []uint8{}, txt: "Selection 0000000"},
+		{servo: Select(0, 5), selection: true, servos: []uint8{0, 5}, txt: "Selection 0100001"},
+		{servo: Select(6, 1, 7, 9), selection: true, servos: []uint8{1, 6}, txt: "Selection 1000010"},
+		{servo: ServoAll, selection: true, servos: []uint8{0, 1, 2, 3, 4, 5, 6}, txt: "Selection 1111111"}}
+	for _, ts := range tests {
+		if ts.servo.IsSelection() != ts.selection {
+			t.Fatalf("Error TestSelect: wrong selection for 0x%02x (%t).", uint8(ts.servo), ts.servo.IsSelection())
+		}
+		if r := ts.servo.Servos(); !reflect.DeepEqual(r, ts.servos) {
+			t.Fatalf("Error TestSelect: wrong servos for 0x%02x (%v != %v).", uint8(ts.servo), r, ts.servos)
+		}
+		if r := ts.servo.String(); r != ts.txt {
+			t.Fatalf("Error TestSelect: wrong string for 0x%02x (%s != %s).", uint8(ts.servo), r, ts.txt)
+		}
+	}
+	if s := Select(0, 5); s != Servo(0xa1) {
+		t.Fatalf("Error TestSelect: wrong selection 0x%02x.", uint8(s))
+	}
+}
+
+func TestPosition(t *testing.T) {
+	f := fakedevice.New(t, uid)
+	defer f.Done()
+	b := f.Bricker()
+	if err := SetPositionFuture(b, "virtual", uid, &ServoPosition{Servo: Select(0, 5), Position: -9000}); err != nil {
+		t.Fatalf("Error TestPosition: SetPosition failed (%s).", err)
+	}
+	if r := f.Request(function_set_position); !bytes.Equal(r, []byte{0xa1, 0xd8, 0xdc}) {
+		t.Fatalf("Error TestPosition: wrong payload % x.", r)
+	}
+	f.Respond(function_get_position, &Position{Value: 4500})
+	if r, err := GetPositionFuture(b, "virtual", uid, Servo(5)); err != nil || r.Value != 4500 {
+		t.Fatalf("Error TestPosition: wrong position (%v, %s).", r, err)
+	}
+	if r := f.Request(function_get_position); !bytes.Equal(r, []byte{5}) {
+		t.Fatalf("Error TestPosition: wrong servo % x.", r)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package servo
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetVelocity creates the subscriber to set the maximal velocity of a servo or a selection of servos.
+// The velocity is given in °/100s and is accelerated with the value of SetAcceleration.
+// The maximal value 65535 means no velocity limit, the servo moves as fast as possible.
+func SetVelocity(id string, uid uint32, sv *ServoVelocity, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetVelocity"),
+		Fid:        function_set_velocity,
+		Uid:        uid,
+		Data:       sv,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32, sv *ServoVelocity) error {
+	return device.FutureEmpty(brick, connectorname, SetVelocity("setvelocityfuture"+device.GenId(), uid, sv, nil))
+}
+
+// GetVelocity creates the subscriber to get the velocity of a servo, which was set with SetVelocity.
+func GetVelocity(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetVelocity"),
+		Fid:        function_get_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetVelocity("getvelocityfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetCurrentVelocity creates the subscriber to get the current velocity of a servo.
+// The value is between 0 and the set velocity, while the servo accelerates.
+func GetCurrentVelocity(id string, uid uint32, s Servo, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentVelocity"),
+		Fid:        function_get_current_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32, s Servo) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetCurrentVelocity("getcurrentvelocityfuture"+device.GenId(), uid, s, nil))
+}
+
+// VelocityReached creates the subscriber for the velocity reached callback.
+// The callback is triggered, if a velocity set by SetVelocity is reached.
+// The callback must be enabled with EnableVelocityReachedCallback.
+// With no acceleration limit the callback is triggered directly after the call of SetVelocity.
+func VelocityReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "VelocityReached"),
+		Fid:        callback_velocity_reached,
+		Uid:        uid,
+		Result:     &ServoVelocity{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// EnableVelocityReachedCallback creates the subscriber to enable the VelocityReached callback.
+func EnableVelocityReachedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "EnableVelocityReachedCallback"),
+		Fid:        function_enable_velocity_reached_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnableVelocityReachedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnableVelocityReachedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, EnableVelocityReachedCallback("enablevelocityreachedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// DisableVelocityReachedCallback creates the subscriber to disable the VelocityReached callback.
+func DisableVelocityReachedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DisableVelocityReachedCallback"),
+		Fid:        function_disable_velocity_reached_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisableVelocityReachedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisableVelocityReachedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DisableVelocityReachedCallback("disablevelocityreachedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// IsVelocityReachedCallbackEnabled creates the subscriber to get the state of the VelocityReached callback.
+func IsVelocityReachedCallbackEnabled(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsVelocityReachedCallbackEnabled"),
+		Fid:        function_is_velocity_reached_callback_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsVelocityReachedCallbackEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsVelocityReachedCallbackEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsVelocityReachedCallbackEnabled("isvelocityreachedcallbackenabledfuture"+device.GenId(), uid, nil))
+}
+
+// ServoVelocity is the type to set the velocity of a servo or a selection of servos.
+// It is also the result of the VelocityReached callback.
+type ServoVelocity struct {
+	Servo    Servo
+	Velocity uint16 // °/100s
+}
+
+// FromPacket creates from a packet a ServoVelocity.
+func (s *ServoVelocity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *ServoVelocity) String() string {
+	txt := "Servo velocity "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Servo: %s, Velocity: %d °/100s]", s.Servo, s.Velocity)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *ServoVelocity) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &ServoVelocity{Servo: s.Servo, Velocity: s.Velocity}
+}
+
+// Velocity is the velocity of a servo in °/100s.
+type Velocity struct {
+	Value uint16 // °/100s
+}
+
+// FromPacket creates from a packet a Velocity.
+func (v *Velocity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Velocity) String() string {
+	txt := "Velocity "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d °/100s]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Velocity) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Velocity{Value: v.Value}
+}
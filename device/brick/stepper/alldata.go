@@ -0,0 +1,172 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetAllData creates the subscriber to get all data of the stepper motor at once.
+// The result contains the current velocity, position, remaining steps, voltages and current consumption.
+func GetAllData(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAllData"),
+		Fid:        function_get_all_data,
+		Uid:        uid,
+		Result:     &AllData{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAllDataFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAllDataFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AllData, error) {
+	return device.FutureOf[*AllData](brick, connectorname, GetAllData("getalldatafuture"+device.GenId(), uid, nil))
+}
+
+// SetAllDataPeriod creates the subscriber to set the period (ms) of the AllDataPeriod callback.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+func SetAllDataPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAllDataPeriod"),
+		Fid:        function_set_all_data_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAllDataPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAllDataPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAllDataPeriod("setalldataperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAllDataPeriod creates a subscriber to get the period of the AllDataPeriod callback.
+func GetAllDataPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAllDataPeriod"),
+		Fid:        function_get_all_data_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAllDataPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAllDataPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAllDataPeriod("getalldataperiodfuture"+device.GenId(), uid, nil))
+}
+
+// AllDataPeriod creates the subscriber for the periodical all data callback.
+// The period is set with SetAllDataPeriod.
+func AllDataPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AllDataPeriod"),
+		Fid:        callback_all_data,
+		Uid:        uid,
+		Result:     &AllData{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// StateChanged creates the subscriber for the new state callback.
+// The callback is triggered, if the stepper motor changes the state (e.g. from acceleration to run).
+func StateChanged(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StateChanged"),
+		Fid:        callback_new_state,
+		Uid:        uid,
+		Result:     &State{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AllData contains all data of the stepper motor.
+type AllData struct {
+	CurrentVelocity    uint16 // steps/s
+	CurrentPosition    int32
+	RemainingSteps     int32
+	StackVoltage       uint16 // mV
+	ExternalVoltage    uint16 // mV
+	CurrentConsumption uint16 // mA
+}
+
+// FromPacket creates from a packet a AllData.
+func (a *AllData) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *AllData) String() string {
+	txt := "All data "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Current velocity: %d steps/s, Current position: %d, Remaining steps: %d, "+
+			"Stack voltage: %d mV, External voltage: %d mV, Current consumption: %d mA]",
+			a.CurrentVelocity, a.CurrentPosition, a.RemainingSteps,
+			a.StackVoltage, a.ExternalVoltage, a.CurrentConsumption)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *AllData) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &AllData{
+		CurrentVelocity:    a.CurrentVelocity,
+		CurrentPosition:    a.CurrentPosition,
+		RemainingSteps:     a.RemainingSteps,
+		StackVoltage:       a.StackVoltage,
+		ExternalVoltage:    a.ExternalVoltage,
+		CurrentConsumption: a.CurrentConsumption}
+}
+
+// State is the new and the previous state of the stepper motor.
+type State struct {
+	New      uint8
+	Previous uint8
+}
+
+// FromPacket creates from a packet a State.
+func (s *State) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *State) String() string {
+	txt := "State "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[New: %s (%d), Previous: %s (%d)]",
+			StateName(s.New), s.New, StateName(s.Previous), s.Previous)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *State) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &State{New: s.New, Previous: s.Previous}
+}
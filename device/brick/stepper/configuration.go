@@ -0,0 +1,317 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetStepMode creates the subscriber to set the step resolution.
+// Possible values are full step (1), half step (2), quarter step (4) and eighth step (8).
+// A higher resolution gives a smoother movement, but less torque.
+func SetStepMode(id string, uid uint32, sm *StepMode, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStepMode"),
+		Fid:        function_set_step_mode,
+		Uid:        uid,
+		Data:       sm,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStepModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStepModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, sm *StepMode) error {
+	return device.FutureEmpty(brick, connectorname, SetStepMode("setstepmodefuture"+device.GenId(), uid, sm, nil))
+}
+
+// GetStepMode creates the subscriber to get the step mode, which was set with SetStepMode.
+func GetStepMode(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStepMode"),
+		Fid:        function_get_step_mode,
+		Uid:        uid,
+		Result:     &StepMode{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStepModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStepModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*StepMode, error) {
+	return device.FutureOf[*StepMode](brick, connectorname, GetStepMode("getstepmodefuture"+device.GenId(), uid, nil))
+}
+
+// SetDecay creates the subscriber to set the decay mode of the stepper motor.
+// The value goes from 0 (fast decay) to 65535 (slow decay), the default is 10000.
+// The decay should be changed only with a running sync rect.
+func SetDecay(id string, uid uint32, d *Decay, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDecay"),
+		Fid:        function_set_decay,
+		Uid:        uid,
+		Data:       d,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDecayFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDecayFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *Decay) error {
+	return device.FutureEmpty(brick, connectorname, SetDecay("setdecayfuture"+device.GenId(), uid, d, nil))
+}
+
+// GetDecay creates the subscriber to get the decay, which was set with SetDecay.
+func GetDecay(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDecay"),
+		Fid:        function_get_decay,
+		Uid:        uid,
+		Result:     &Decay{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDecayFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDecayFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Decay, error) {
+	return device.FutureOf[*Decay](brick, connectorname, GetDecay("getdecayfuture"+device.GenId(), uid, nil))
+}
+
+// SetSyncRect creates the subscriber to turn the synchronous rectification on or off.
+// With synchronous rectification the decay could be set with SetDecay.
+func SetSyncRect(id string, uid uint32, sr *SyncRect, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSyncRect"),
+		Fid:        function_set_sync_rect,
+		Uid:        uid,
+		Data:       NewSyncRectRaw(sr),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSyncRectFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSyncRectFuture(brick *bricker.Bricker, connectorname string, uid uint32, sr *SyncRect) error {
+	return device.FutureEmpty(brick, connectorname, SetSyncRect("setsyncrectfuture"+device.GenId(), uid, sr, nil))
+}
+
+// IsSyncRect creates the subscriber to get the state of the synchronous rectification.
+func IsSyncRect(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsSyncRect"),
+		Fid:        function_is_sync_rect,
+		Uid:        uid,
+		Result:     &SyncRect{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsSyncRectFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsSyncRectFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*SyncRect, error) {
+	return device.FutureOf[*SyncRect](brick, connectorname, IsSyncRect("issyncrectfuture"+device.GenId(), uid, nil))
+}
+
+// SetTimeBase creates the subscriber to set the time base of the velocity and acceleration (s).
+// With a time base of 60 the velocity is given in steps/minute instead of steps/s.
+func SetTimeBase(id string, uid uint32, tb *TimeBase, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetTimeBase"),
+		Fid:        function_set_time_base,
+		Uid:        uid,
+		Data:       tb,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetTimeBaseFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetTimeBaseFuture(brick *bricker.Bricker, connectorname string, uid uint32, tb *TimeBase) error {
+	return device.FutureEmpty(brick, connectorname, SetTimeBase("settimebasefuture"+device.GenId(), uid, tb, nil))
+}
+
+// GetTimeBase creates the subscriber to get the time base, which was set with SetTimeBase.
+func GetTimeBase(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTimeBase"),
+		Fid:        function_get_time_base,
+		Uid:        uid,
+		Result:     &TimeBase{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTimeBaseFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTimeBaseFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*TimeBase, error) {
+	return device.FutureOf[*TimeBase](brick, connectorname, GetTimeBase("gettimebasefuture"+device.GenId(), uid, nil))
+}
+
+// StepMode is the step resolution of the stepper motor.
+type StepMode struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a StepMode.
+func (sm *StepMode) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(sm, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(sm)
+}
+
+// Name gives a readable representation of the step mode as string.
+func (sm *StepMode) Name() string {
+	if sm == nil {
+		return ""
+	}
+	return StepModeName(sm.Value)
+}
+
+// String fullfill the stringer interface.
+func (sm *StepMode) String() string {
+	txt := "Step mode "
+	if sm == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", sm.Name(), sm.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (sm *StepMode) Copy() device.Resulter {
+	if sm == nil {
+		return nil
+	}
+	return &StepMode{Value: sm.Value}
+}
+
+// Decay is the decay mode, 0 is fast decay and 65535 is slow decay.
+type Decay struct {
+	Value uint16
+}
+
+// FromPacket creates from a packet a Decay.
+func (d *Decay) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *Decay) String() string {
+	txt := "Decay "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", d.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *Decay) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &Decay{Value: d.Value}
+}
+
+// SyncRect is the state of the synchronous rectification.
+type SyncRect struct {
+	Value bool // true - on, false - off
+}
+
+// FromPacket converts the packet payload to the SyncRect type.
+func (sr *SyncRect) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(sr, p); err != nil {
+		return err
+	}
+	srr := new(SyncRectRaw)
+	err := p.Payload.Decode(srr)
+	if err == nil {
+		sr.FromSyncRectRaw(srr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (sr *SyncRect) String() string {
+	txt := "Sync rect "
+	if sr == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", sr.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (sr *SyncRect) Copy() device.Resulter {
+	if sr == nil {
+		return nil
+	}
+	return &SyncRect{Value: sr.Value}
+}
+
+// FromSyncRectRaw converts the SyncRectRaw into a SyncRect.
+func (sr *SyncRect) FromSyncRectRaw(srr *SyncRectRaw) {
+	if sr == nil || srr == nil {
+		return
+	}
+	sr.Value = misc.Uint8ToBool(srr.Value)
+}
+
+// SyncRectRaw is the real de/encoding type for a SyncRect.
+type SyncRectRaw struct {
+	Value uint8
+}
+
+// NewSyncRectRaw creates a new SyncRectRaw from a SyncRect.
+func NewSyncRectRaw(sr *SyncRect) *SyncRectRaw {
+	if sr == nil {
+		return nil
+	}
+	return &SyncRectRaw{Value: misc.BoolToUint8(sr.Value)}
+}
+
+// TimeBase is the time base of the velocity and acceleration in seconds.
+type TimeBase struct {
+	Value uint32 // s
+}
+
+// FromPacket creates from a packet a TimeBase.
+func (t *TimeBase) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// String fullfill the stringer interface.
+func (t *TimeBase) String() string {
+	txt := "TimeBase "
+	if t == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d s]", t.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (t *TimeBase) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &TimeBase{Value: t.Value}
+}
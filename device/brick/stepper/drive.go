@@ -0,0 +1,192 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// DriveForward creates the subscriber to drive the stepper motor forward.
+// The motor drives until DriveBackward, Stop or FullBrake is called.
+func DriveForward(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DriveForward"),
+		Fid:        function_drive_forward,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DriveForwardFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DriveForwardFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DriveForward("driveforwardfuture"+device.GenId(), uid, nil))
+}
+
+// DriveBackward creates the subscriber to drive the stepper motor backward.
+// The motor drives until DriveForward, Stop or FullBrake is called.
+func DriveBackward(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DriveBackward"),
+		Fid:        function_drive_backward,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DriveBackwardFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DriveBackwardFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DriveBackward("drivebackwardfuture"+device.GenId(), uid, nil))
+}
+
+// Stop creates the subscriber to stop the stepper motor with the deceleration of SetSpeedRamping.
+func Stop(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Stop"),
+		Fid:        function_stop,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// StopFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func StopFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Stop("stopfuture"+device.GenId(), uid, nil))
+}
+
+// FullBrake creates the subscriber to stop the stepper motor immediately.
+// The motor is stopped without deceleration, so the motor may loose steps.
+// Use Stop for a normal stop.
+func FullBrake(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "FullBrake"),
+		Fid:        function_full_brake,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// FullBrakeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func FullBrakeFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, FullBrake("fullbrakefuture"+device.GenId(), uid, nil))
+}
+
+// Enable creates the subscriber to enable the driver of the stepper motor.
+// The configuration (velocity, speed ramping, ...) should be done before enabling.
+func Enable(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Enable"),
+		Fid:        function_enable,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnableFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Enable("enablefuture"+device.GenId(), uid, nil))
+}
+
+// Disable creates the subscriber to disable the driver of the stepper motor.
+// The motor should be stopped before, otherwise it may loose steps.
+func Disable(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Disable"),
+		Fid:        function_disable,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisableFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisableFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Disable("disablefuture"+device.GenId(), uid, nil))
+}
+
+// IsEnabled creates the subscriber to get the enabled state of the driver.
+func IsEnabled(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsEnabled"),
+		Fid:        function_is_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsEnabled("isenabledfuture"+device.GenId(), uid, nil))
+}
+
+// IsEnabledFutureSimple calls the IsEnabledFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsEnabledFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	e, err := IsEnabledFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return e.Value, nil
+}
+
+// Enabled is a type for showing if the driver is enabled or disabled.
+type Enabled struct {
+	Value bool // true - enabled, false - disabled
+}
+
+// FromPacket converts the packet payload to the Enabled type.
+func (e *Enabled) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(EnabledRaw)
+	err := p.Payload.Decode(er)
+	if err == nil && er != nil {
+		e.FromEnabledRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (e *Enabled) String() string {
+	txt := "Enabled "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", e.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Enabled) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Enabled{Value: e.Value}
+}
+
+// FromEnabledRaw converts the EnabledRaw into a Enabled.
+func (e *Enabled) FromEnabledRaw(er *EnabledRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Value = misc.Uint8ToBool(er.Value)
+}
+
+// EnabledRaw is the real de/encoding type for a Enabled.
+type EnabledRaw struct {
+	Value uint8
+}
@@ -0,0 +1,129 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetCurrentPosition creates the subscriber to set the current step position.
+// The position is only a counter of the brick, the motor is not moved (e.g. to set the zero position).
+func SetCurrentPosition(id string, uid uint32, po *Position, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentPosition"),
+		Fid:        function_set_current_position,
+		Uid:        uid,
+		Data:       po,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Position) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentPosition("setcurrentpositionfuture"+device.GenId(), uid, po, nil))
+}
+
+// GetCurrentPosition creates the subscriber to get the current step position.
+// Every step forward increases the counter, every step backward decreases it.
+func GetCurrentPosition(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentPosition"),
+		Fid:        function_get_current_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetCurrentPosition("getcurrentpositionfuture"+device.GenId(), uid, nil))
+}
+
+// SetTargetPosition creates the subscriber to set the target position.
+// The stepper motor drives with the configured velocity and speed ramping to the target position.
+// If the target position is reached, the PositionReached callback is triggered.
+func SetTargetPosition(id string, uid uint32, po *Position, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetTargetPosition"),
+		Fid:        function_set_target_position,
+		Uid:        uid,
+		Data:       po,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetTargetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetTargetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Position) error {
+	return device.FutureEmpty(brick, connectorname, SetTargetPosition("settargetpositionfuture"+device.GenId(), uid, po, nil))
+}
+
+// GetTargetPosition creates the subscriber to get the target position, which was set with SetTargetPosition.
+func GetTargetPosition(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTargetPosition"),
+		Fid:        function_get_target_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTargetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTargetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetTargetPosition("gettargetpositionfuture"+device.GenId(), uid, nil))
+}
+
+// PositionReached creates the subscriber for the position reached callback.
+// The callback is triggered, if a position set by SetTargetPosition or SetSteps is reached.
+func PositionReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "PositionReached"),
+		Fid:        callback_position_reached,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Position is the step position of the stepper motor.
+type Position struct {
+	Value int32
+}
+
+// FromPacket creates from a packet a Position.
+func (po *Position) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(po, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(po)
+}
+
+// String fullfill the stringer interface.
+func (po *Position) String() string {
+	txt := "Position "
+	if po == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", po.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (po *Position) Copy() device.Resulter {
+	if po == nil {
+		return nil
+	}
+	return &Position{Value: po.Value}
+}
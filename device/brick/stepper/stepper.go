@@ -0,0 +1,100 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Stepper Brick.
+package stepper
+
+const (
+	function_set_max_velocity           = uint8(1)
+	function_get_max_velocity           = uint8(2)
+	function_get_current_velocity       = uint8(3)
+	function_set_speed_ramping          = uint8(4)
+	function_get_speed_ramping          = uint8(5)
+	function_full_brake                 = uint8(6)
+	function_set_current_position       = uint8(7)
+	function_get_current_position       = uint8(8)
+	function_set_target_position        = uint8(9)
+	function_get_target_position        = uint8(10)
+	function_set_steps                  = uint8(11)
+	function_get_steps                  = uint8(12)
+	function_get_remaining_steps        = uint8(13)
+	function_set_step_mode              = uint8(14)
+	function_get_step_mode              = uint8(15)
+	function_drive_forward              = uint8(16)
+	function_drive_backward             = uint8(17)
+	function_stop                       = uint8(18)
+	function_get_stack_input_voltage    = uint8(19)
+	function_get_external_input_voltage = uint8(20)
+	function_get_current_consumption    = uint8(21)
+	function_set_motor_current          = uint8(22)
+	function_get_motor_current          = uint8(23)
+	function_enable                     = uint8(24)
+	function_disable                    = uint8(25)
+	function_is_enabled                 = uint8(26)
+	function_set_decay                  = uint8(27)
+	function_get_decay                  = uint8(28)
+	function_set_minimum_voltage        = uint8(29)
+	function_get_minimum_voltage        = uint8(30)
+	function_set_sync_rect              = uint8(33)
+	function_is_sync_rect               = uint8(34)
+	function_set_time_base              = uint8(35)
+	function_get_time_base              = uint8(36)
+	function_get_all_data               = uint8(37)
+	function_set_all_data_period        = uint8(38)
+	function_get_all_data_period        = uint8(39)
+	function_get_chip_temperature       = uint8(242)
+	function_reset                      = uint8(243)
+	callback_under_voltage              = uint8(31)
+	callback_position_reached           = uint8(32)
+	callback_all_data                   = uint8(40)
+	callback_new_state                  = uint8(41)
+	// Step modes
+	StepModeFullStep    = uint8(1)
+	StepModeHalfStep    = uint8(2)
+	StepModeQuarterStep = uint8(4)
+	StepModeEighthStep  = uint8(8)
+	// States of the stepper motor
+	StateStop                      = uint8(1)
+	StateAcceleration              = uint8(2)
+	StateRun                       = uint8(3)
+	StateDeacceleration            = uint8(4)
+	StateDirectionChangeToForward  = uint8(5)
+	StateDirectionChangeToBackward = uint8(6)
+)
+
+// StepModeName results a string representation of the given step mode.
+func StepModeName(m uint8) string {
+	switch m {
+	case StepModeFullStep:
+		return "Full Step"
+	case StepModeHalfStep:
+		return "Half Step"
+	case StepModeQuarterStep:
+		return "Quarter Step"
+	case StepModeEighthStep:
+		return "Eighth Step"
+	default:
+		return "Unknown"
+	}
+}
+
+// StateName results a string representation of the given state of the stepper motor.
+func StateName(s uint8) string {
+	switch s {
+	case StateStop:
+		return "Stop"
+	case StateAcceleration:
+		return "Acceleration"
+	case StateRun:
+		return "Run"
+	case StateDeacceleration:
+		return "Deacceleration"
+	case StateDirectionChangeToForward:
+		return "Direction Change To Forward"
+	case StateDirectionChangeToBackward:
+		return "Direction Change To Backward"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/util/fakedevice"
+	"testing"
+	"time"
+)
+
+const uid = uint32(4711)
+
+// newStepper creates a fake stepper brick, the getter of all setters has the next function id.
+func newStepper(t *testing.T) (*bricker.Bricker, *fakedevice.Device) {
+	f := fakedevice.New(t, uid)
+	for _, fid := range []uint8{function_set_max_velocity, function_set_speed_ramping, function_set_current_position,
+		function_set_target_position, function_set_steps, function_set_step_mode, function_set_motor_current,
+		function_set_decay, function_set_minimum_voltage, function_set_sync_rect, function_set_time_base,
+		function_set_all_data_period} {
+		f.Store(fid, fid+1)
+	}
+	return f.Bricker(), f
+}
+
+func TestSetGet(t *testing.T) {
+	b, f := newStepper(t)
+	defer f.Done()
+	if err := SetMaxVelocityFuture(b, "virtual", uid, &Velocity{Value: 2000}); err != nil {
+		t.Fatalf("Error TestSetGet: SetMaxVelocity failed (%s).", err)
+	}
+	if r, err := GetMaxVelocityFuture(b, "virtual", uid); err != nil || r.Value != 2000 {
+		t.Fatalf("Error TestSetGet: wrong max velocity (%v, %s).", r, err)
+	}
+	sr := &SpeedRamping{Acceleration: 1000, Deceleration: 500}
+	if err := SetSpeedRampingFuture(b, "virtual", uid, sr); err != nil {
+		t.Fatalf("Error TestSetGet: SetSpeedRamping failed (%s).", err)
+	}
+	if r, err := GetSpeedRampingFuture(b, "virtual", uid); err != nil || *r != *sr {
+		t.Fatalf("Error TestSetGet: wrong speed ramping (%v, %s).", r, err)
+	}
+	if err := SetTargetPositionFuture(b, "virtual", uid, &Position{Value: -123456}); err != nil {
+		t.Fatalf("Error TestSetGet: SetTargetPosition failed (%s).", err)
+	}
+	if r, err := GetTargetPositionFuture(b, "virtual", uid); err != nil || r.Value != -123456 {
+		t.Fatalf("Error TestSetGet: wrong target position (%v, %s).", r, err)
+	}
+	if err := SetStepsFuture(b, "virtual", uid, &Steps{Value: 800}); err != nil {
+		t.Fatalf("Error TestSetGet: SetSteps failed (%s).", err)
+	}
+	if r, err := GetStepsFuture(b, "virtual", uid); err != nil || r.Value != 800 {
+		t.Fatalf("Error TestSetGet: wrong steps (%v, %s).", r, err)
+	}
+	if err := SetStepModeFuture(b, "virtual", uid, &StepMode{Value: StepModeEighthStep}); err != nil {
+		t.Fatalf("Error TestSetGet: SetStepMode failed (%s).", err)
+	}
+	if r, err := GetStepModeFuture(b, "virtual", uid); err != nil || r.Value != StepModeEighthStep || r.Name() != "Eighth Step" {
+		t.Fatalf("Error TestSetGet: wrong step mode (%v, %s).", r, err)
+	}
+	if err := SetMotorCurrentFuture(b, "virtual", uid, &Current{Value: 1200}); err != nil {
+		t.Fatalf("Error TestSetGet: SetMotorCurrent failed (%s).", err)
+	}
+	if r, err := GetMotorCurrentFuture(b, "virtual", uid); err != nil || r.Value != 1200 {
+		t.Fatalf("Error TestSetGet: wrong motor current (%v, %s).", r, err)
+	}
+	if err := SetDecayFuture(b, "virtual", uid, &Decay{Value: 10000}); err != nil {
+		t.Fatalf("Error TestSetGet: SetDecay failed (%s).", err)
+	}
+	if r, err := GetDecayFuture(b, "virtual", uid); err != nil || r.Value != 10000 {
+		t.Fatalf("Error TestSetGet: wrong decay (%v, %s).", r, err)
+	}
+	if err := SetSyncRectFuture(b, "virtual", uid, &SyncRect{Value: true}); err != nil {
+		t.Fatalf("Error TestSetGet: SetSyncRect failed (%s).", err)
+	}
+	if r, err := IsSyncRectFuture(b, "virtual", uid); err != nil || !r.Value {
+		t.Fatalf("Error TestSetGet: wrong sync rect (%v, %s).", r, err)
+	}
+	if err := SetTimeBaseFuture(b, "virtual", uid, &TimeBase{Value: 60}); err != nil {
+		t.Fatalf("Error TestSetGet: SetTimeBase failed (%s).", err)
+	}
+	if r, err := GetTimeBaseFuture(b, "virtual", uid); err != nil || r.Value != 60 {
+		t.Fatalf("Error TestSetGet: wrong time base (%v, %s).", r, err)
+	}
+	if err := SetAllDataPeriodFuture(b, "virtual", uid, &device.Period{Value: 250}); err != nil {
+		t.Fatalf("Error TestSetGet: SetAllDataPeriod failed (%s).", err)
+	}
+	if r, err := GetAllDataPeriodFuture(b, "virtual", uid); err != nil || r.Value != 250 {
+		t.Fatalf("Error TestSetGet: wrong all data period (%v, %s).", r, err)
+	}
+}
+
+func TestGetAllData(t *testing.T) {
+	b, f := newStepper(t)
+	defer f.Done()
+	ad := &AllData{CurrentVelocity: 1500, CurrentPosition: -42, RemainingSteps: 100,
+		StackVoltage: 12000, ExternalVoltage: 0, CurrentConsumption: 820}
+	f.Respond(function_get_all_data, ad)
+	r, err := GetAllDataFuture(b, "virtual", uid)
+	if err != nil {
+		t.Fatalf("Error TestGetAllData: call failed (%s).", err)
+	}
+	if *r != *ad {
+		t.Fatalf("Error TestGetAllData: wrong all data (%s != %s).", r, ad)
+	}
+	if c, ok := r.Copy().(*AllData); !ok || *c != *ad {
+		t.Fatalf("Error TestGetAllData: wrong copy (%v).", c)
+	}
+}
+
+func TestDrive(t *testing.T) {
+	b, f := newStepper(t)
+	defer f.Done()
+	calls := []struct {
+		fid  uint8
+		call func(*bricker.Bricker, string, uint32) error
+	}{{fid: function_enable, call: EnableFuture},
+		{fid: function_drive_forward, call: DriveForwardFuture},
+		{fid: function_drive_backward, call: DriveBackwardFuture},
+		{fid: function_stop, call: StopFuture},
+		{fid: function_full_brake, call: FullBrakeFuture},
+		{fid: function_disable, call: DisableFuture}}
+	for _, c := range calls {
+		if err := c.call(b, "virtual", uid); err != nil {
+			t.Fatalf("Error TestDrive: call of function %d failed (%s).", c.fid, err)
+		}
+		if !f.Called(c.fid) {
+			t.Fatalf("Error TestDrive: function %d not called.", c.fid)
+		}
+	}
+}
+
+func TestCallbacks(t *testing.T) {
+	b, f := newStepper(t)
+	defer f.Done()
+	results := make(chan device.Resulter, 3)
+	handler := func(r device.Resulter, err error) {
+		if err == nil {
+			results <- r
+		}
+	}
+	for _, d := range []*device.Device{
+		PositionReached("reached", uid, handler),
+		AllDataPeriod("alldata", uid, handler),
+		StateChanged("state", uid, handler)} {
+		if err := b.Subscribe(d, "virtual"); err != nil {
+			t.Fatalf("Error TestCallbacks: subscribe failed (%s).", err)
+		}
+	}
+	wait := func() device.Resulter {
+		select {
+		case r := <-results:
+			return r
+		case <-time.After(5 * time.Second):
+			t.Fatal("Error TestCallbacks: no callback.")
+		}
+		return nil
+	}
+	f.Emit(callback_position_reached, &Position{Value: 3200})
+	if r, ok := wait().(*Position); !ok || r.Value != 3200 {
+		t.Fatalf("Error TestCallbacks: wrong position reached (%v).", r)
+	}
+	ad := &AllData{CurrentVelocity: 10, CurrentPosition: 20, RemainingSteps: 30}
+	f.Emit(callback_all_data, ad)
+	if r, ok := wait().(*AllData); !ok || *r != *ad {
+		t.Fatalf("Error TestCallbacks: wrong all data (%v).", r)
+	}
+	f.Emit(callback_new_state, &State{New: StateRun, Previous: StateAcceleration})
+	if r, ok := wait().(*State); !ok || r.New != StateRun || r.Previous != StateAcceleration {
+		t.Fatalf("Error TestCallbacks: wrong state (%v).", r)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetSteps creates the subscriber to set the number of steps, the motor should run.
+// Positive values drive forward, negative values drive backward.
+// The target position is the current position plus the steps.
+func SetSteps(id string, uid uint32, s *Steps, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSteps"),
+		Fid:        function_set_steps,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStepsFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStepsFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *Steps) error {
+	return device.FutureEmpty(brick, connectorname, SetSteps("setstepsfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetSteps creates the subscriber to get the steps, which were set with SetSteps.
+func GetSteps(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSteps"),
+		Fid:        function_get_steps,
+		Uid:        uid,
+		Result:     &Steps{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStepsFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStepsFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Steps, error) {
+	return device.FutureOf[*Steps](brick, connectorname, GetSteps("getstepsfuture"+device.GenId(), uid, nil))
+}
+
+// GetRemainingSteps creates the subscriber to get the remaining steps of the last call of SetSteps.
+// If SetSteps was called with 2000 and the result is 1000, the motor is halfway.
+func GetRemainingSteps(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetRemainingSteps"),
+		Fid:        function_get_remaining_steps,
+		Uid:        uid,
+		Result:     &Steps{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetRemainingStepsFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetRemainingStepsFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Steps, error) {
+	return device.FutureOf[*Steps](brick, connectorname, GetRemainingSteps("getremainingstepsfuture"+device.GenId(), uid, nil))
+}
+
+// Steps is a number of steps of the stepper motor.
+type Steps struct {
+	Value int32
+}
+
+// FromPacket creates from a packet a Steps.
+func (s *Steps) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *Steps) String() string {
+	txt := "Steps "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", s.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *Steps) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &Steps{Value: s.Value}
+}
@@ -0,0 +1,165 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stepper
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetMaxVelocity creates the subscriber to set the maximal velocity (steps/s).
+// The motor is accelerated and decelerated with the values of SetSpeedRamping.
+// DriveForward, DriveBackward, SetTargetPosition and SetSteps use the maximal velocity.
+func SetMaxVelocity(id string, uid uint32, v *Velocity, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMaxVelocity"),
+		Fid:        function_set_max_velocity,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMaxVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMaxVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Velocity) error {
+	return device.FutureEmpty(brick, connectorname, SetMaxVelocity("setmaxvelocityfuture"+device.GenId(), uid, v, nil))
+}
+
+// GetMaxVelocity creates the subscriber to get the maximal velocity, which was set with SetMaxVelocity.
+func GetMaxVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMaxVelocity"),
+		Fid:        function_get_max_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMaxVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMaxVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetMaxVelocity("getmaxvelocityfuture"+device.GenId(), uid, nil))
+}
+
+// GetCurrentVelocity creates the subscriber to get the current velocity (steps/s) of the stepper motor.
+func GetCurrentVelocity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentVelocity"),
+		Fid:        function_get_current_velocity,
+		Uid:        uid,
+		Result:     &Velocity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentVelocityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentVelocityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Velocity, error) {
+	return device.FutureOf[*Velocity](brick, connectorname, GetCurrentVelocity("getcurrentvelocityfuture"+device.GenId(), uid, nil))
+}
+
+// SetSpeedRamping creates the subscriber to set the acceleration and deceleration (steps/s²).
+// A value of 0 stops the acceleration or deceleration, the maximal value 65535 means no speed ramping.
+func SetSpeedRamping(id string, uid uint32, sr *SpeedRamping, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSpeedRamping"),
+		Fid:        function_set_speed_ramping,
+		Uid:        uid,
+		Data:       sr,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSpeedRampingFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSpeedRampingFuture(brick *bricker.Bricker, connectorname string, uid uint32, sr *SpeedRamping) error {
+	return device.FutureEmpty(brick, connectorname, SetSpeedRamping("setspeedrampingfuture"+device.GenId(), uid, sr, nil))
+}
+
+// GetSpeedRamping creates the subscriber to get the speed ramping, which was set with SetSpeedRamping.
+func GetSpeedRamping(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSpeedRamping"),
+		Fid:        function_get_speed_ramping,
+		Uid:        uid,
+		Result:     &SpeedRamping{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSpeedRampingFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSpeedRampingFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*SpeedRamping, error) {
+	return device.FutureOf[*SpeedRamping](brick, connectorname, GetSpeedRamping("getspeedrampingfuture"+device.GenId(), uid, nil))
+}
+
+// Velocity is the velocity of the stepper motor in steps/s.
+type Velocity struct {
+	Value uint16 // steps/s
+}
+
+// FromPacket creates from a packet a Velocity.
+func (v *Velocity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Velocity) String() string {
+	txt := "Velocity "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d steps/s]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Velocity) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Velocity{Value: v.Value}
+}
+
+// SpeedRamping is the acceleration and deceleration of the stepper motor in steps/s².
+type SpeedRamping struct {
+	Acceleration uint16 // steps/s²
+	Deceleration uint16 // steps/s²
+}
+
+// FromPacket creates from a packet a SpeedRamping.
+func (sr *SpeedRamping) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(sr, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(sr)
+}
+
+// String fullfill the stringer interface.
+func (sr *SpeedRamping) String() string {
+	txt := "Speed ramping "
+	if sr == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Acceleration: %d steps/s², Deceleration: %d steps/s²]", sr.Acceleration, sr.Deceleration)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (sr *SpeedRamping) Copy() device.Resulter {
+	if sr == nil {
+		return nil
+	}
+	return &SpeedRamping{Acceleration: sr.Acceleration, Deceleration: sr.Deceleration}
+}
@@ -22,25 +22,9 @@ func GetIlluminance(id string, uid uint32, handler func(device.Resulter, error))
 }
 
 // GetIlluminanceFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetIlluminanceFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Illuminance {
-	future := make(chan *Illuminance)
-	defer close(future)
-	sub := GetIlluminance("getilluminancefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Illuminance = nil
-			if err == nil {
-				if value, ok := r.(*Illuminance); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetIlluminanceFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Illuminance, error) {
+	return device.FutureOf[*Illuminance](brick, connectorname, GetIlluminance("getilluminancefuture"+device.GenId(), uid, nil))
 }
 
 // Illuminance is a type for the illuminance value.
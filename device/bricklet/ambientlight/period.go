@@ -19,23 +19,14 @@ func SetIlluminanceCallbackPeriod(id string, uid uint32, pe *device.Period, hand
 		Uid:        uid,
 		Data:       pe,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetIlluminanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetIlluminanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetIlluminanceCallbackPeriod("setilluminancecallbackperiodfuture"+device.GenId(), uid, pe,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetIlluminanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetIlluminanceCallbackPeriod("setilluminancecallbackperiodfuture"+device.GenId(), uid, pe, nil))
 }
 
 // GetIlluminanceCallbackPeriod creates a subsctiber to get the callback period value.
@@ -50,25 +41,9 @@ func GetIlluminanceCallbackPeriod(id string, uid uint32, handler func(device.Res
 }
 
 // GetIlluminanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subsctiber.
-// If an error occur, the result is nil.
-func GetIlluminanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Period {
-	future := make(chan *device.Period)
-	defer close(future)
-	sub := GetIlluminanceCallbackPeriod("getilluminancecallbackperiodfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Period = nil
-			if err == nil {
-				if value, ok := r.(*device.Period); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetIlluminanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetIlluminanceCallbackPeriod("getilluminancecallbackperiodfuture"+device.GenId(), uid, nil))
 }
 
 // SetAnalogValueCallbackPeriod creates the subscriber to set the callback period.
@@ -81,23 +56,14 @@ func SetAnalogValueCallbackPeriod(id string, uid uint32, pe *device.Period, hand
 		Uid:        uid,
 		Data:       pe,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAnalogValueCallbackPeriod("setanalogvaluecallbackperiodfuture"+device.GenId(), uid, pe,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAnalogValueCallbackPeriod("setanalogvaluecallbackperiodfuture"+device.GenId(), uid, pe, nil))
 }
 
 // GetAnalogValueCallbackPeriod creates a subsctiber to get the callback period value.
@@ -112,25 +78,9 @@ func GetAnalogValueCallbackPeriod(id string, uid uint32, handler func(device.Res
 }
 
 // GetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subsctiber.
-// If an error occur, the result is nil.
-func GetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Period {
-	future := make(chan *device.Period)
-	defer close(future)
-	sub := GetAnalogValueCallbackPeriod("getanalogvaluecallbackperiodfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Period = nil
-			if err == nil {
-				if value, ok := r.(*device.Period); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAnalogValueCallbackPeriod("getanalogvaluecallbackperiodfuture"+device.GenId(), uid, nil))
 }
 
 // IlluminancePeriod creates a subscriber for the periodical illuminance callback.
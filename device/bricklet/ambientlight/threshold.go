@@ -18,23 +18,14 @@ func SetIlluminanceCallbackThreshold(id string, uid uint32, t *device.Threshold1
 		Uid:        uid,
 		Data:       t,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetIlluminanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetIlluminanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetIlluminanceCallbackThreshold("setilluminancecallbackthresholdfuture"+device.GenId(), uid, t,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetIlluminanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetIlluminanceCallbackThreshold("setilluminancecallbackthresholdfuture"+device.GenId(), uid, t, nil))
 }
 
 // GetIlluminanceCallbackThreshold creates the subscriber to get the callback thresold.
@@ -49,25 +40,9 @@ func GetIlluminanceCallbackThreshold(id string, uid uint32, handler func(device.
 }
 
 // GetIlluminanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetIlluminanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Threshold16 {
-	future := make(chan *device.Threshold16)
-	defer close(future)
-	sub := GetIlluminanceCallbackThreshold("getilluminancecallbackthresholdfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Threshold16 = nil
-			if err == nil {
-				if value, ok := r.(*device.Threshold16); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetIlluminanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetIlluminanceCallbackThreshold("getilluminancecallbackthresholdfuture"+device.GenId(), uid, nil))
 }
 
 // SetAnalogValueCallbackThreshold creates the subscriber to set the callback thresold.
@@ -79,23 +54,14 @@ func SetAnalogValueCallbackThreshold(id string, uid uint32, t *device.Threshold1
 		Uid:        uid,
 		Data:       t,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAnalogValueCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAnalogValueCallbackThreshold("setanalogvaluecallbackthresholdfuture"+device.GenId(), uid, t,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetAnalogValueCallbackThreshold("setanalogvaluecallbackthresholdfuture"+device.GenId(), uid, t, nil))
 }
 
 // GetAnalogValueCallbackThreshold creates the subscriber to get the callback thresold.
@@ -110,25 +76,9 @@ func GetAnalogValueCallbackThreshold(id string, uid uint32, handler func(device.
 }
 
 // GetAnalogValueCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Threshold16 {
-	future := make(chan *device.Threshold16)
-	defer close(future)
-	sub := GetAnalogValueCallbackThreshold("getanalogvaluecallbackthresholdfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Threshold16 = nil
-			if err == nil {
-				if value, ok := r.(*device.Threshold16); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetAnalogValueCallbackThreshold("getanalogvaluecallbackthresholdfuture"+device.GenId(), uid, nil))
 }
 
 // IlluminanceReached creates a subscriber for the theshold triggered voltage callback.
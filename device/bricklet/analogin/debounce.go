@@ -18,23 +18,14 @@ func SetDebouncePeriod(id string, uid uint32, d *device.Debounce, handler func(d
 		Uid:        uid,
 		Data:       d,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetDebouncePeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *device.Debounce) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetDebouncePeriod("setdebounceperiodfuture"+device.GenId(), uid, d,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *device.Debounce) error {
+	return device.FutureEmpty(brick, connectorname, SetDebouncePeriod("setdebounceperiodfuture"+device.GenId(), uid, d, nil))
 }
 
 // GetDebouncePeriod creates the subscriber to get the debounce period.
@@ -49,23 +40,7 @@ func GetDebouncePeriod(id string, uid uint32, handler func(device.Resulter, erro
 }
 
 // GetDebouncePeriodFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Debounce {
-	future := make(chan *device.Debounce)
-	defer close(future)
-	sub := GetDebouncePeriod("getdebounceperiodfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Debounce = nil
-			if err == nil {
-				if value, ok := r.(*device.Debounce); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Debounce, error) {
+	return device.FutureOf[*device.Debounce](brick, connectorname, GetDebouncePeriod("getdebounceperiodfuture"+device.GenId(), uid, nil))
 }
@@ -32,19 +32,9 @@ func SetRange(id string, uid uint32, r *Range, handler func(device.Resulter, err
 }
 
 // SetRangeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetRangeFuture(brick bricker.Bricker, connectorname string, uid uint32, r *Range) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetRange("setrangefuture"+device.GenId(), uid, r,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Range) error {
+	return device.FutureEmpty(brick, connectorname, SetRange("setrangefuture"+device.GenId(), uid, r, nil))
 }
 
 // GetRange creates a subscriber to get the measurement range value.
@@ -59,25 +49,9 @@ func GetRange(id string, uid uint32, handler func(device.Resulter, error)) *devi
 }
 
 // GetRangeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetRangeFuture(brick bricker.Bricker, connectorname string, uid uint32) *Range {
-	future := make(chan *Range)
-	defer close(future)
-	sub := GetRange("getrangefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Range = nil
-			if err == nil {
-				if value, ok := r.(*Range); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetRangeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Range, error) {
+	return device.FutureOf[*Range](brick, connectorname, GetRange("getrangefuture"+device.GenId(), uid, nil))
 }
 
 // Constants for the range.
@@ -23,26 +23,9 @@ func GetVoltage(id string, uid uint32, handler func(device.Resulter, error)) *de
 }
 
 // GetVoltageFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetVoltageFuture(brick bricker.Bricker, connectorname string, uid uint32) *Voltage {
-	future := make(chan *Voltage)
-	defer close(future)
-	sub := GetVoltage("getvoltagefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Voltage = nil
-			if err == nil {
-				if value, ok := r.(*Voltage); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetVoltage("getvoltagefuture"+device.GenId(), uid, nil))
 }
 
 // Voltage result type
@@ -31,19 +31,9 @@ func SetMode(id string, uid uint32, m *Mode, handler func(device.Resulter, error
 }
 
 // SetModeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetModeFuture(brick bricker.Bricker, connectorname string, uid uint32, m *Mode) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetMode("setmodefuture"+device.GenId(), uid, m,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Mode) error {
+	return device.FutureEmpty(brick, connectorname, SetMode("setmodefuture"+device.GenId(), uid, m, nil))
 }
 
 // GetMode creates a subscriber to get the measurement mode value.
@@ -58,25 +48,9 @@ func GetMode(id string, uid uint32, handler func(device.Resulter, error)) *devic
 }
 
 // GetModeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetModeFuture(brick bricker.Bricker, connectorname string, uid uint32) *Mode {
-	future := make(chan *Mode)
-	defer close(future)
-	sub := GetMode("getmodefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Mode = nil
-			if err == nil {
-				if value, ok := r.(*Mode); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Mode, error) {
+	return device.FutureOf[*Mode](brick, connectorname, GetMode("getmodefuture"+device.GenId(), uid, nil))
 }
 
 // Constants for the modes.
@@ -23,19 +23,9 @@ func SetVoltage(id string, uid uint32, v *Voltage, handler func(device.Resulter,
 }
 
 // SetRangeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetVoltageFuture(brick bricker.Bricker, connectorname string, uid uint32, v *Voltage) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetVoltage("setvoltagefuture"+device.GenId(), uid, v,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Voltage) error {
+	return device.FutureEmpty(brick, connectorname, SetVoltage("setvoltagefuture"+device.GenId(), uid, v, nil))
 }
 
 // GetVoltage creates A subscriber to return the actual voltage (mV).
@@ -50,26 +40,9 @@ func GetVoltage(id string, uid uint32, handler func(device.Resulter, error)) *de
 }
 
 // GetVoltageFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetVoltageFuture(brick bricker.Bricker, connectorname string, uid uint32) *Voltage {
-	future := make(chan *Voltage)
-	defer close(future)
-	sub := GetVoltage("getvoltagefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Voltage = nil
-			if err == nil {
-				if value, ok := r.(*Voltage); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetVoltage("getvoltagefuture"+device.GenId(), uid, nil))
 }
 
 // Value in a range from 0 - 5000 in mV.
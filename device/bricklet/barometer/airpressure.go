@@ -23,25 +23,9 @@ func GetAirPressure(id string, uid uint32, handler func(device.Resulter, error))
 }
 
 // GetAirPressureFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32) *AirPressure {
-	future := make(chan *AirPressure)
-	defer close(future)
-	sub := GetAirPressure("getairpressurefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *AirPressure = nil
-			if err == nil {
-				if value, ok := r.(*AirPressure); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AirPressure, error) {
+	return device.FutureOf[*AirPressure](brick, connectorname, GetAirPressure("getairpressurefuture"+device.GenId(), uid, nil))
 }
 
 /*
@@ -23,25 +23,9 @@ func GetAltitude(id string, uid uint32, handler func(device.Resulter, error)) *d
 }
 
 // GetAltitudeFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetAltitudeFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Altitude {
-	future := make(chan *Altitude)
-	defer close(future)
-	sub := GetAltitude("getaltitudefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Altitude = nil
-			if err == nil {
-				if value, ok := r.(*Altitude); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAltitudeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Altitude, error) {
+	return device.FutureOf[*Altitude](brick, connectorname, GetAltitude("getaltitudefuture"+device.GenId(), uid, nil))
 }
 
 /*
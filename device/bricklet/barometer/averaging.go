@@ -34,19 +34,9 @@ func SetAveraging(id string, uid uint32, a *Average, handler func(device.Resulte
 }
 
 // SetAveragingFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAveragingFuture(brick bricker.Bricker, connectorname string, uid uint32, a *Average) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAveraging("setaveragingfuture"+device.GenId(), uid, a,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAveragingFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *Average) error {
+	return device.FutureEmpty(brick, connectorname, SetAveraging("setaveragingfuture"+device.GenId(), uid, a, nil))
 }
 
 // GetAveraging creates a subscriber to get the different averaging values.
@@ -61,25 +51,9 @@ func GetAveraging(id string, uid uint32, handler func(device.Resulter, error)) *
 }
 
 // GetAveragingFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetAveragingFuture(brick bricker.Bricker, connectorname string, uid uint32) *Average {
-	future := make(chan *Average)
-	defer close(future)
-	sub := GetAveraging("getaveragingfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Average = nil
-			if err == nil {
-				if value, ok := r.(*Average); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAveragingFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Average, error) {
+	return device.FutureOf[*Average](brick, connectorname, GetAveraging("getaveragingfuture"+device.GenId(), uid, nil))
 }
 
 // Average is the type for the length of a averaging for the voltage value.
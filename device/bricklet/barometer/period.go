@@ -19,23 +19,14 @@ func SetAirPressureCallbackPeriod(id string, uid uint32, pe *device.Period, hand
 		Uid:        uid,
 		Data:       pe,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAirPressureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAirPressureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAirPressureCallbackPeriod("setairpressurecallbackperiodfuture"+device.GenId(), uid, pe,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAirPressureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAirPressureCallbackPeriod("setairpressurecallbackperiodfuture"+device.GenId(), uid, pe, nil))
 }
 
 // GetAirPressureCallbackPeriod creates a subsctiber to get the callback period value.
@@ -50,25 +41,9 @@ func GetAirPressureCallbackPeriod(id string, uid uint32, handler func(device.Res
 }
 
 // GetAirPressureCallbackPeriodFuture is a future pattern version for a synchronized call of the subsctiber.
-// If an error occur, the result is nil.
-func GetAirPressureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Period {
-	future := make(chan *device.Period)
-	defer close(future)
-	sub := GetAirPressureCallbackPeriod("getairpressurecallbackperiod"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Period = nil
-			if err == nil {
-				if value, ok := r.(*device.Period); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAirPressureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAirPressureCallbackPeriod("getairpressurecallbackperiod"+device.GenId(), uid, nil))
 }
 
 // SetAltitudeCallbackPeriod creates the subscriber to set the callback period.
@@ -81,23 +56,14 @@ func SetAltitudeCallbackPeriod(id string, uid uint32, pe *device.Period, handler
 		Uid:        uid,
 		Data:       pe,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAltitudeCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAltitudeCallbackPeriod("setaltitudecallbackperiodfuture"+device.GenId(), uid, pe,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAltitudeCallbackPeriod("setaltitudecallbackperiodfuture"+device.GenId(), uid, pe, nil))
 }
 
 // GetAltitudeCallbackPeriod creates a subscriber to get the callback period value.
@@ -112,25 +78,9 @@ func GetAltitudeCallbackPeriod(id string, uid uint32, handler func(device.Result
 }
 
 // GetAltitudeCallbackPeriodFuture is a future pattern version for a synchronized call of the subsctiber.
-// If an error occur, the result is nil.
-func GetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Period {
-	future := make(chan *device.Period)
-	defer close(future)
-	sub := GetAltitudeCallbackPeriod("getaltitudecallbackperiodfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Period = nil
-			if err == nil {
-				if value, ok := r.(*device.Period); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAltitudeCallbackPeriod("getaltitudecallbackperiodfuture"+device.GenId(), uid, nil))
 }
 
 // AirPressurePeriod creates a subscriber for the periodical air pressure callback.
@@ -29,19 +29,9 @@ func SetReferenceAirPressure(id string, uid uint32, a *AirPressure, handler func
 }
 
 // SetReferenceAirPressureFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetReferenceAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *AirPressure) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetReferenceAirPressure("setreferenceairpressurefuture"+device.GenId(), uid, a,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetReferenceAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *AirPressure) error {
+	return device.FutureEmpty(brick, connectorname, SetReferenceAirPressure("setreferenceairpressurefuture"+device.GenId(), uid, a, nil))
 }
 
 // GetReferenceAirPressure creates the subscriber to get reference air pressure.
@@ -56,23 +46,7 @@ func GetReferenceAirPressure(id string, uid uint32, handler func(device.Resulter
 }
 
 // GetReferenceAirPressureFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetReferenceAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32) *AirPressure {
-	future := make(chan *AirPressure)
-	defer close(future)
-	sub := GetReferenceAirPressure("getreferenceairpressure"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *AirPressure = nil
-			if err == nil {
-				if value, ok := r.(*AirPressure); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetReferenceAirPressureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AirPressure, error) {
+	return device.FutureOf[*AirPressure](brick, connectorname, GetReferenceAirPressure("getreferenceairpressure"+device.GenId(), uid, nil))
 }
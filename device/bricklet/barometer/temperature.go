@@ -23,25 +23,9 @@ func GetChipTemperature(id string, uid uint32, handler func(device.Resulter, err
 }
 
 // GetAveragingFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetChipTemperatureFuture(brick bricker.Bricker, connectorname string, uid uint32) *Temperature {
-	future := make(chan *Temperature)
-	defer close(future)
-	sub := GetChipTemperature("getchiptemperaturefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Temperature = nil
-			if err == nil {
-				if value, ok := r.(*Temperature); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetChipTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetChipTemperature("getchiptemperaturefuture"+device.GenId(), uid, nil))
 }
 
 // Temperature type with a value 100/°C in a range between -4000 to 8500.
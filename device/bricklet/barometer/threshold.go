@@ -18,23 +18,14 @@ func SetAirPressureCallbackThreshold(id string, uid uint32, t *device.Threshold3
 		Uid:        uid,
 		Data:       t,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAirPressureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAirPressureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAirPressureCallbackThreshold("setairpressurecallbackthresholdfuture"+device.GenId(), uid, t,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAirPressureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetAirPressureCallbackThreshold("setairpressurecallbackthresholdfuture"+device.GenId(), uid, t, nil))
 }
 
 // GetAirPressureCallbackThreshold creates the subscriber to get the callback threshold.
@@ -49,25 +40,9 @@ func GetAirPressureCallbackThreshold(id string, uid uint32, handler func(device.
 }
 
 // GetAirPressureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetAirPressureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Threshold32 {
-	future := make(chan *device.Threshold32)
-	defer close(future)
-	sub := GetAirPressureCallbackThreshold("getairpressurecallbackthresholdfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Threshold32 = nil
-			if err == nil {
-				if value, ok := r.(*device.Threshold32); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAirPressureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetAirPressureCallbackThreshold("getairpressurecallbackthresholdfuture"+device.GenId(), uid, nil))
 }
 
 // SetAltitudeCallbackThreshold creates the subscriber to set the callback threshold.
@@ -79,23 +54,14 @@ func SetAltitudeCallbackThreshold(id string, uid uint32, t *device.Threshold32,
 		Uid:        uid,
 		Data:       t,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetAltitudeCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetAltitudeCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetAltitudeCallbackThreshold("setaltitudecallbackthresholdfuture"+device.GenId(), uid, t,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetAltitudeCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetAltitudeCallbackThreshold("setaltitudecallbackthresholdfuture"+device.GenId(), uid, t, nil))
 }
 
 // GetAltitudeCallbackThreshold creates the subscriber to get the callback threshold.
@@ -110,25 +76,9 @@ func GetAltitudeCallbackThreshold(id string, uid uint32, handler func(device.Res
 }
 
 // GetAltitudeCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetAltitudeCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Threshold32 {
-	future := make(chan *device.Threshold32)
-	defer close(future)
-	sub := GetAltitudeCallbackThreshold("getaltitudecallbackthresholdfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Threshold32 = nil
-			if err == nil {
-				if value, ok := r.(*device.Threshold32); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetAltitudeCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetAltitudeCallbackThreshold("getaltitudecallbackthresholdfuture"+device.GenId(), uid, nil))
 }
 
 // AirPressureReached creates a subscriber for the theshold triggered air pressure callback.
@@ -0,0 +1,200 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Current12 Bricklet.
+package current12
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+const (
+	function_get_current                         = uint8(1)
+	function_calibrate                           = uint8(2)
+	function_is_over_current                     = uint8(3)
+	function_get_analog_value                    = uint8(4)
+	function_set_current_callback_period         = uint8(5)
+	function_get_current_callback_period         = uint8(6)
+	function_set_analog_value_callback_period    = uint8(7)
+	function_get_analog_value_callback_period    = uint8(8)
+	function_set_current_callback_threshold      = uint8(9)
+	function_get_current_callback_threshold      = uint8(10)
+	function_set_analog_value_callback_threshold = uint8(11)
+	function_get_analog_value_callback_threshold = uint8(12)
+	function_set_debounce_period                 = uint8(13)
+	function_get_debounce_period                 = uint8(14)
+	callback_current                             = uint8(15)
+	callback_analog_value                        = uint8(16)
+	callback_current_reached                     = uint8(17)
+	callback_analog_value_reached                = uint8(18)
+	callback_over_current                        = uint8(19)
+)
+
+// GetCurrent creates a subscriber to get the measured current (mA).
+// For periodical values use the CurrentPeriod callback.
+func GetCurrent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrent"),
+		Fid:        function_get_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetCurrent("getcurrentfuture"+device.GenId(), uid, nil))
+}
+
+// Calibrate creates a subscriber to calibrate the zero value of the sensor.
+// No current should flow during the calibration, the calibration is stored in the flash and only needed once.
+func Calibrate(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Calibrate"),
+		Fid:        function_calibrate,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// CalibrateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func CalibrateFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Calibrate("calibratefuture"+device.GenId(), uid, nil))
+}
+
+// IsOverCurrent creates a subscriber to get the over current state.
+// The state is true, if more than 12500 mA were measured since the startup (the value of the sensor was not valid).
+// The state is reset on the next startup.
+func IsOverCurrent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsOverCurrent"),
+		Fid:        function_is_over_current,
+		Uid:        uid,
+		Result:     &OverCurrentState{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsOverCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsOverCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*OverCurrentState, error) {
+	return device.FutureOf[*OverCurrentState](brick, connectorname, IsOverCurrent("isovercurrentfuture"+device.GenId(), uid, nil))
+}
+
+// IsOverCurrentFutureSimple calls the IsOverCurrentFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsOverCurrentFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	oc, err := IsOverCurrentFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return oc.IsOverCurrent, nil
+}
+
+// OverCurrent creates a subscriber for the over current callback.
+// There are no result inside, the handler is called, if a over current was measured.
+func OverCurrent(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "OverCurrent"),
+		Fid:        callback_over_current,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Current is the type of the measured current.
+// The value has a range of -12500 to 12500 mA.
+type Current struct {
+	Value int16 // mA
+}
+
+// FromPacket creates from a packet a Current.
+func (c *Current) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Current) String() string {
+	txt := "Current "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mA]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Current) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Current{Value: c.Value}
+}
+
+// Float64 converts the current value (mA) into A.
+func (c *Current) Float64() float64 {
+	return float64(c.Value) / 1000.0
+}
+
+// OverCurrentState is the type for the return of the IsOverCurrent subscriber.
+type OverCurrentState struct {
+	IsOverCurrent bool
+}
+
+// FromPacket converts the packet payload to the OverCurrentState type.
+func (oc *OverCurrentState) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(oc, p); err != nil {
+		return err
+	}
+	ocr := new(OverCurrentStateRaw)
+	err := p.Payload.Decode(ocr)
+	if err == nil {
+		oc.FromOverCurrentStateRaw(ocr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (oc *OverCurrentState) String() string {
+	txt := "Over current state "
+	if oc == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[IsOverCurrent: %t]", oc.IsOverCurrent)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (oc *OverCurrentState) Copy() device.Resulter {
+	if oc == nil {
+		return nil
+	}
+	return &OverCurrentState{IsOverCurrent: oc.IsOverCurrent}
+}
+
+// FromOverCurrentStateRaw converts a OverCurrentStateRaw into a OverCurrentState.
+func (oc *OverCurrentState) FromOverCurrentStateRaw(ocr *OverCurrentStateRaw) {
+	if oc == nil || ocr == nil {
+		return
+	}
+	oc.IsOverCurrent = misc.Uint8ToBool(ocr.IsOverCurrent)
+}
+
+// OverCurrentStateRaw is the real de/encoding type for a OverCurrentState.
+type OverCurrentStateRaw struct {
+	IsOverCurrent uint8
+}
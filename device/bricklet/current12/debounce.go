@@ -0,0 +1,46 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package current12
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetDebouncePeriod creates the subscriber to set the debounce period.
+// The default value is 100.
+func SetDebouncePeriod(id string, uid uint32, d *device.Debounce, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDebouncePeriod"),
+		Fid:        function_set_debounce_period,
+		Uid:        uid,
+		Data:       d,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDebouncePeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *device.Debounce) error {
+	return device.FutureEmpty(brick, connectorname, SetDebouncePeriod("setdebounceperiodfuture"+device.GenId(), uid, d, nil))
+}
+
+// GetDebouncePeriod creates the subscriber to get the debounce period.
+func GetDebouncePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDebouncePeriod"),
+		Fid:        function_get_debounce_period,
+		Uid:        uid,
+		Result:     &device.Debounce{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDebouncePeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDebouncePeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Debounce, error) {
+	return device.FutureOf[*device.Debounce](brick, connectorname, GetDebouncePeriod("getdebounceperiodfuture"+device.GenId(), uid, nil))
+}
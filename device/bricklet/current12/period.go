@@ -0,0 +1,110 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package current12
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCurrentCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// CurrentPeriod is only triggered if the current has changed since the last triggering.
+func SetCurrentCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentCallbackPeriod"),
+		Fid:        function_set_current_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentCallbackPeriod("setcurrentcallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetCurrentCallbackPeriod creates a subscriber to get the callback period value.
+func GetCurrentCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentCallbackPeriod"),
+		Fid:        function_get_current_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetCurrentCallbackPeriod("getcurrentcallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetAnalogValueCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AnalogValuePeriod is only triggered if the analog value has changed since the last triggering.
+func SetAnalogValueCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAnalogValueCallbackPeriod"),
+		Fid:        function_set_analog_value_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAnalogValueCallbackPeriod("setanalogvaluecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAnalogValueCallbackPeriod creates a subscriber to get the callback period value.
+func GetAnalogValueCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAnalogValueCallbackPeriod"),
+		Fid:        function_get_analog_value_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAnalogValueCallbackPeriod("getanalogvaluecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// CurrentPeriod creates a subscriber for the periodical current callback.
+// Is only triggered if the current changed, since last triggering.
+func CurrentPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CurrentPeriod"),
+		Fid:        callback_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AnalogValuePeriod creates a subscriber for the periodical analog value callback.
+// Is only triggered if the analog value changed, since last triggering.
+func AnalogValuePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AnalogValuePeriod"),
+		Fid:        callback_analog_value,
+		Uid:        uid,
+		Result:     &AnalogValue{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Distance IR Bricklet.
+package distanceir
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_distance                        = uint8(1)
+	function_get_analog_value                    = uint8(2)
+	function_set_sampling_point                  = uint8(3)
+	function_get_sampling_point                  = uint8(4)
+	function_set_distance_callback_period        = uint8(5)
+	function_get_distance_callback_period        = uint8(6)
+	function_set_analog_value_callback_period    = uint8(7)
+	function_get_analog_value_callback_period    = uint8(8)
+	function_set_distance_callback_threshold     = uint8(9)
+	function_get_distance_callback_threshold     = uint8(10)
+	function_set_analog_value_callback_threshold = uint8(11)
+	function_get_analog_value_callback_threshold = uint8(12)
+	function_set_debounce_period                 = uint8(13)
+	function_get_debounce_period                 = uint8(14)
+	callback_distance                            = uint8(15)
+	callback_analog_value                        = uint8(16)
+	callback_distance_reached                    = uint8(17)
+	callback_analog_value_reached                = uint8(18)
+	// Sampling points
+	SamplingPoints = uint8(128) // number of sampling points of the lookup table
+)
+
+// GetDistance creates a subscriber to get the distance (mm).
+// The measurable range depends on the used sensor, the distance is computed with the sampling points.
+// For periodical values use the DistancePeriod callback.
+func GetDistance(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDistance"),
+		Fid:        function_get_distance,
+		Uid:        uid,
+		Result:     &Distance{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDistanceFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDistanceFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Distance, error) {
+	return device.FutureOf[*Distance](brick, connectorname, GetDistance("getdistancefuture"+device.GenId(), uid, nil))
+}
+
+// Distance is the type of the distance value.
+// The value is in mm.
+type Distance struct {
+	Value uint16 // mm
+}
+
+// FromPacket create from a packet a distance value.
+func (d *Distance) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *Distance) String() string {
+	txt := "Distance "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mm]", d.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *Distance) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &Distance{Value: d.Value}
+}
+
+// Float64 converts the distance value (mm) into cm.
+func (d *Distance) Float64() float64 {
+	return float64(d.Value) / 10.0
+}
@@ -0,0 +1,110 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distanceir
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetDistanceCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// DistancePeriod is only triggered if the distance has changed since the last triggering.
+func SetDistanceCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDistanceCallbackPeriod"),
+		Fid:        function_set_distance_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDistanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDistanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetDistanceCallbackPeriod("setdistancecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetDistanceCallbackPeriod creates a subscriber to get the callback period value.
+func GetDistanceCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDistanceCallbackPeriod"),
+		Fid:        function_get_distance_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDistanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDistanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetDistanceCallbackPeriod("getdistancecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetAnalogValueCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AnalogValuePeriod is only triggered if the analog value has changed since the last triggering.
+func SetAnalogValueCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAnalogValueCallbackPeriod"),
+		Fid:        function_set_analog_value_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAnalogValueCallbackPeriod("setanalogvaluecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAnalogValueCallbackPeriod creates a subscriber to get the callback period value.
+func GetAnalogValueCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAnalogValueCallbackPeriod"),
+		Fid:        function_get_analog_value_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAnalogValueCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAnalogValueCallbackPeriod("getanalogvaluecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// DistancePeriod creates a subscriber for the periodical distance callback.
+// Is only triggered if the distance changed, since last triggering.
+func DistancePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DistancePeriod"),
+		Fid:        callback_distance,
+		Uid:        uid,
+		Result:     &Distance{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AnalogValuePeriod creates a subscriber for the periodical analog value callback.
+// Is only triggered if the analog value changed, since last triggering.
+func AnalogValuePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AnalogValuePeriod"),
+		Fid:        callback_analog_value,
+		Uid:        uid,
+		Result:     &AnalogValue{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,60 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distanceir
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetSamplingPoint creates a subscriber to set a sampling point of the lookup table.
+// The lookup table has 128 equidistant analog values (position * 32) with the corresponding distances (mm).
+// The distances between the sampling points are interpolated linear.
+// The sampling points are stored in the EEPROM and loaded on startup, the factory values fit the shipped sensor.
+func SetSamplingPoint(id string, uid uint32, sp *SamplingPoint, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSamplingPoint"),
+		Fid:        function_set_sampling_point,
+		Uid:        uid,
+		Data:       sp,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSamplingPointFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSamplingPointFuture(brick *bricker.Bricker, connectorname string, uid uint32, sp *SamplingPoint) error {
+	return device.FutureEmpty(brick, connectorname, SetSamplingPoint("setsamplingpointfuture"+device.GenId(), uid, sp, nil))
+}
+
+// GetSamplingPoint creates a subscriber to get the distance (mm) of a sampling point of the lookup table.
+func GetSamplingPoint(id string, uid uint32, position uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSamplingPoint"),
+		Fid:        function_get_sampling_point,
+		Uid:        uid,
+		Result:     &Distance{},
+		Data:       position,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSamplingPointFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSamplingPointFuture(brick *bricker.Bricker, connectorname string, uid uint32, position uint8) (*Distance, error) {
+	return device.FutureOf[*Distance](brick, connectorname, GetSamplingPoint("getsamplingpointfuture"+device.GenId(), uid, position, nil))
+}
+
+// SamplingPoint is a entry of the lookup table.
+// The position has a range of 0 to 127 and corresponds to the analog value (position * 32).
+type SamplingPoint struct {
+	Position uint8
+	Distance uint16 // mm
+}
+
+// AnalogValue gives the analog value of the sampling point.
+func (sp *SamplingPoint) AnalogValue() uint16 {
+	return uint16(sp.Position) * 32
+}
@@ -0,0 +1,80 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Distance US Bricklet.
+package distanceus
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_distance_value              = uint8(1)
+	function_set_distance_callback_period    = uint8(2)
+	function_get_distance_callback_period    = uint8(3)
+	function_set_distance_callback_threshold = uint8(4)
+	function_get_distance_callback_threshold = uint8(5)
+	function_set_debounce_period             = uint8(6)
+	function_get_debounce_period             = uint8(7)
+	function_set_moving_average              = uint8(10)
+	function_get_moving_average              = uint8(11)
+	callback_distance                        = uint8(8)
+	callback_distance_reached                = uint8(9)
+)
+
+// GetDistanceValue creates a subscriber to get the distance value.
+// For periodical values use the DistancePeriod callback.
+func GetDistanceValue(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDistanceValue"),
+		Fid:        function_get_distance_value,
+		Uid:        uid,
+		Result:     &Distance{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDistanceValueFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDistanceValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Distance, error) {
+	return device.FutureOf[*Distance](brick, connectorname, GetDistanceValue("getdistancevaluefuture"+device.GenId(), uid, nil))
+}
+
+// Distance is the type of the distance value.
+// The value has a range of 0 to 4095, it is not in a unit of length.
+// A small value means a short distance, a big value corresponds to a long distance.
+// The relation between the value and the distance depends on the ultrasonic sensor and the environment.
+type Distance struct {
+	Value uint16
+}
+
+// FromPacket create from a packet a distance value.
+func (d *Distance) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *Distance) String() string {
+	txt := "Distance "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", d.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *Distance) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &Distance{Value: d.Value}
+}
@@ -0,0 +1,88 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distanceus
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+/*
+SetMovingAverage creates a subscriber to set a length of the moving average.
+The default value is 20.
+*/
+func SetMovingAverage(id string, uid uint32, a *Average, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMovingAverage"),
+		Fid:        function_set_moving_average,
+		Uid:        uid,
+		Data:       a,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMovingAverageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *Average) error {
+	return device.FutureEmpty(brick, connectorname, SetMovingAverage("setmovingaveragefuture"+device.GenId(), uid, a, nil))
+}
+
+// GetMovingAverage creates a subscriber to get the length of the moving average.
+func GetMovingAverage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMovingAverage"),
+		Fid:        function_get_moving_average,
+		Uid:        uid,
+		Result:     &Average{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMovingAverageFuture is a future pattern version for a synchronized calll of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Average, error) {
+	return device.FutureOf[*Average](brick, connectorname, GetMovingAverage("getmovingaveragefuture"+device.GenId(), uid, nil))
+}
+
+/*
+Average is the type for the averaging length.
+A value 0 is turn the averaging completely off.
+With less averaging the distance value contains more noise.
+The range for the averaging is 0-100.
+More information in the Tinkerforge documentation:
+http://www.tinkerforge.com/en/doc/Software/Bricklets/DistanceUS_Bricklet_TCPIP.html#BrickletDistanceUS.set_moving_average
+*/
+type Average struct {
+	Value uint8
+}
+
+// FromPacket create from a packet a Average.
+func (a *Average) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill stringer interface.
+func (a *Average) String() string {
+	txt := "Average "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", a.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Average) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Average{Value: a.Value}
+}
@@ -0,0 +1,58 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distanceus
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetDistanceCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetDistanceCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDistanceCallbackThreshold"),
+		Fid:        function_set_distance_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDistanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDistanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetDistanceCallbackThreshold("setdistancecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetDistanceCallbackThreshold creates the subscriber to get the callback thresold.
+func GetDistanceCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDistanceCallbackThreshold"),
+		Fid:        function_get_distance_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDistanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDistanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetDistanceCallbackThreshold("getdistancecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// DistanceReached creates a subscriber for the threshold triggered distance callback.
+func DistanceReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DistanceReached"),
+		Fid:        callback_distance_reached,
+		Uid:        uid,
+		Result:     &Distance{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -23,25 +23,9 @@ func GetButtonState(id string, uid uint32, handler func(device.Resulter, error))
 }
 
 // GetButtonStateFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetButtonStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) *ButtonState {
-	future := make(chan *ButtonState)
-	defer close(future)
-	sub := GetButtonState("getbuttonstatefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *ButtonState = nil
-			if err == nil {
-				if value, ok := r.(*ButtonState); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetButtonStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ButtonState, error) {
+	return device.FutureOf[*ButtonState](brick, connectorname, GetButtonState("getbuttonstatefuture"+device.GenId(), uid, nil))
 }
 
 /*
@@ -27,19 +27,9 @@ func SetLedState(id string, uid uint32, ls *LedState, handler func(device.Result
 }
 
 // SetLedStateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, ls *LedState) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetLedState("setledstatefuture"+device.GenId(), uid, ls,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, ls *LedState) error {
+	return device.FutureEmpty(brick, connectorname, SetLedState("setledstatefuture"+device.GenId(), uid, ls, nil))
 }
 
 // GetLedState creates the subscriber to get the led states.
@@ -54,25 +44,9 @@ func GetLedState(id string, uid uint32, handler func(device.Resulter, error)) *d
 }
 
 // GetLedStateFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) *LedState {
-	future := make(chan *LedState)
-	defer close(future)
-	sub := GetLedState("getledstatefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *LedState = nil
-			if err == nil {
-				if value, ok := r.(*LedState); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*LedState, error) {
+	return device.FutureOf[*LedState](brick, connectorname, GetLedState("getledstatefuture"+device.GenId(), uid, nil))
 }
 
 // SetSelectedLedState creates a subscriber for setting a selected led state.
@@ -88,19 +62,9 @@ func SetSelectedLedState(id string, uid uint32, sls *SelectedLedState, handler f
 }
 
 // SetSelectedLedStateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetSelectedLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, sls *SelectedLedState) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetSelectedLedState("setselectedledstatefuture"+device.GenId(), uid, sls,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetSelectedLedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, sls *SelectedLedState) error {
+	return device.FutureEmpty(brick, connectorname, SetSelectedLedState("setselectedledstatefuture"+device.GenId(), uid, sls, nil))
 }
 
 // StateChanged creates a subscriber for the state changed callback.
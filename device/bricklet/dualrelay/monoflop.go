@@ -24,19 +24,9 @@ func SetMonoflop(id string, uid uint32, m *Monoflops, handler func(device.Result
 }
 
 // SetMonoflopFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Monoflops) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetMonoflop("setmonoflopfuture"+device.GenId(), uid, m,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Monoflops) error {
+	return device.FutureEmpty(brick, connectorname, SetMonoflop("setmonoflopfuture"+device.GenId(), uid, m, nil))
 }
 
 // GetMonoflop creates a subscriber for getting the actual monoflop value.
@@ -52,25 +42,9 @@ func GetMonoflop(id string, uid uint32, r *Relay, handler func(device.Resulter,
 }
 
 // GetMonoflopFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Relay) *Monoflop {
-	future := make(chan *Monoflop)
-	defer close(future)
-	sub := GetMonoflop("getmonoflopfuture"+device.GenId(), uid, r,
-		func(r device.Resulter, err error) {
-			var v *Monoflop = nil
-			if err == nil {
-				if value, ok := r.(*Monoflop); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Relay) (*Monoflop, error) {
+	return device.FutureOf[*Monoflop](brick, connectorname, GetMonoflop("getmonoflopfuture"+device.GenId(), uid, r, nil))
 }
 
 /*
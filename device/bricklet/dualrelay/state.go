@@ -30,19 +30,9 @@ func SetState(id string, uid uint32, s *State, handler func(device.Resulter, err
 }
 
 // SetStateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetStateFuture(brick bricker.Bricker, connectorname string, uid uint32, s *State) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetState("setstatefuture"+device.GenId(), uid, s,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *State) error {
+	return device.FutureEmpty(brick, connectorname, SetState("setstatefuture"+device.GenId(), uid, s, nil))
 }
 
 // GetState creates a subscriber to get the relay states.
@@ -57,25 +47,9 @@ func GetState(id string, uid uint32, handler func(device.Resulter, error)) *devi
 }
 
 // GetStateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetStateFuture(brick bricker.Bricker, connectorname string, uid uint32) *State {
-	future := make(chan *State)
-	defer close(future)
-	sub := GetState("getstatefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *State = nil
-			if err == nil {
-				if value, ok := r.(*State); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*State, error) {
+	return device.FutureOf[*State](brick, connectorname, GetState("getstatefuture"+device.GenId(), uid, nil))
 }
 
 // SetSelectedState creates a subscriber to set only one relay.
@@ -91,19 +65,9 @@ func SetSelectedState(id string, uid uint32, s *SelectedState, handler func(devi
 }
 
 // SetSelectedStateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetSelectedStateFuture(brick bricker.Bricker, connectorname string, uid uint32, s *SelectedState) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetSelectedState("setselectedstatefuture"+device.GenId(), uid, s,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetSelectedStateFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *SelectedState) error {
+	return device.FutureEmpty(brick, connectorname, SetSelectedState("setselectedstatefuture"+device.GenId(), uid, s, nil))
 }
 
 // State holds the state of the relays.
@@ -0,0 +1,69 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetAltitude creates the subscriber to get the altitude and the geoidal separation.
+// The altitude is only valid, if there is a 3D fix (see GetStatus).
+// For periodical values use the AltitudePeriod callback.
+func GetAltitude(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAltitude"),
+		Fid:        function_get_altitude,
+		Uid:        uid,
+		Result:     &Altitude{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAltitudeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAltitudeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Altitude, error) {
+	return device.FutureOf[*Altitude](brick, connectorname, GetAltitude("getaltitudefuture"+device.GenId(), uid, nil))
+}
+
+// Altitude is the altitude above the sea level and the geoidal separation in cm.
+type Altitude struct {
+	Altitude          int32 // cm
+	GeoidalSeparation int32 // cm
+}
+
+// FromPacket creates from a packet a Altitude.
+func (a *Altitude) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *Altitude) String() string {
+	txt := "Altitude "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Altitude: %d cm, Geoidal Separation: %d cm]", a.Altitude, a.GeoidalSeparation)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Altitude) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Altitude{Altitude: a.Altitude, GeoidalSeparation: a.GeoidalSeparation}
+}
+
+// Meters converts the altitude into m.
+func (a *Altitude) Meters() float64 {
+	return float64(a.Altitude) / 100.0
+}
@@ -0,0 +1,134 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	"math"
+)
+
+// EarthRadius is the mean radius of the earth in m, it is used for the distance.
+const EarthRadius = 6371000.0
+
+// GetCoordinates creates the subscriber to get the coordinates.
+// The coordinates are only valid, if there is a fix (see GetStatus).
+// For periodical values use the CoordinatesPeriod callback.
+func GetCoordinates(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCoordinates"),
+		Fid:        function_get_coordinates,
+		Uid:        uid,
+		Result:     &Coordinates{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCoordinatesFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCoordinatesFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Coordinates, error) {
+	return device.FutureOf[*Coordinates](brick, connectorname, GetCoordinates("getcoordinatesfuture"+device.GenId(), uid, nil))
+}
+
+/*
+Coordinates are the position of the GPS receiver.
+
+The latitude and the longitude are given in the DD.dddddd° format
+(the value 57123468 means 57.123468°), the hemispheres are given
+with NS ('N' or 'S') and EW ('E' or 'W').
+
+The dilution of precision values (PDOP, HDOP and VDOP) are given in 1/100
+and the estimated position error (EPE) is given in cm.
+*/
+type Coordinates struct {
+	Latitude  uint32 // 1/1000000 °
+	NS        byte   // 'N' or 'S'
+	Longitude uint32 // 1/1000000 °
+	EW        byte   // 'E' or 'W'
+	PDOP      uint16 // 1/100
+	HDOP      uint16 // 1/100
+	VDOP      uint16 // 1/100
+	EPE       uint16 // cm
+}
+
+// FromPacket creates from a packet a Coordinates.
+func (c *Coordinates) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Coordinates) String() string {
+	txt := "Coordinates "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Latitude: %.6f° %c, Longitude: %.6f° %c, PDOP: %.2f, HDOP: %.2f, VDOP: %.2f, EPE: %d cm]",
+			float64(c.Latitude)/1000000.0, c.NS, float64(c.Longitude)/1000000.0, c.EW,
+			float64(c.PDOP)/100.0, float64(c.HDOP)/100.0, float64(c.VDOP)/100.0, c.EPE)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Coordinates) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Coordinates{
+		Latitude:  c.Latitude,
+		NS:        c.NS,
+		Longitude: c.Longitude,
+		EW:        c.EW,
+		PDOP:      c.PDOP,
+		HDOP:      c.HDOP,
+		VDOP:      c.VDOP,
+		EPE:       c.EPE}
+}
+
+// DecimalDegrees converts the coordinates into signed decimal degrees (°).
+// The southern latitudes and the western longitudes are negative.
+func (c *Coordinates) DecimalDegrees() (latitude, longitude float64) {
+	latitude = float64(c.Latitude) / 1000000.0
+	if c.NS == 'S' {
+		latitude = -latitude
+	}
+	longitude = float64(c.Longitude) / 1000000.0
+	if c.EW == 'W' {
+		longitude = -longitude
+	}
+	return latitude, longitude
+}
+
+// Distance computes the great circle distance (m) between the two coordinates (haversine formula).
+// The earth is a sphere with the radius EarthRadius.
+func (c *Coordinates) Distance(to *Coordinates) float64 {
+	lat1, lon1 := c.radians()
+	lat2, lon2 := to.radians()
+	sinlat := math.Sin((lat2 - lat1) / 2)
+	sinlon := math.Sin((lon2 - lon1) / 2)
+	a := sinlat*sinlat + math.Cos(lat1)*math.Cos(lat2)*sinlon*sinlon
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Bearing computes the initial bearing (°, 0° - 360°, clockwise from north)
+// of the great circle from the coordinates to the other coordinates.
+func (c *Coordinates) Bearing(to *Coordinates) float64 {
+	lat1, lon1 := c.radians()
+	lat2, lon2 := to.radians()
+	y := math.Sin(lon2-lon1) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(lon2-lon1)
+	return math.Mod(math.Atan2(y, x)*180.0/math.Pi+360.0, 360.0)
+}
+
+// radians gives the coordinates in signed radians.
+func (c *Coordinates) radians() (latitude, longitude float64) {
+	latitude, longitude = c.DecimalDegrees()
+	return latitude * math.Pi / 180.0, longitude * math.Pi / 180.0
+}
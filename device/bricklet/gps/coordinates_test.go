@@ -0,0 +1,75 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// coordinates creates coordinates from signed decimal degrees.
+func coordinates(lat, lon float64) *Coordinates {
+	c := &Coordinates{NS: 'N', EW: 'E'}
+	if lat < 0 {
+		c.NS, lat = 'S', -lat
+	}
+	if lon < 0 {
+		c.EW, lon = 'W', -lon
+	}
+	c.Latitude = uint32(math.Round(lat * 1000000))
+	c.Longitude = uint32(math.Round(lon * 1000000))
+	return c
+}
+
+func TestDecimalDegrees(t *testing.T) {
+	tests := []struct {
+		c        *Coordinates
+		lat, lon float64
+	}{{c: &Coordinates{Latitude: 57123468, NS: 'N', Longitude: 12345678, EW: 'E'}, lat: 57.123468, lon: 12.345678},
+		{c: &Coordinates{Latitude: 33868800, NS: 'S', Longitude: 151209300, EW: 'E'}, lat: -33.8688, lon: 151.2093},
+		{c: &Coordinates{Latitude: 40712800, NS: 'N', Longitude: 74006000, EW: 'W'}, lat: 40.7128, lon: -74.006}}
+	for _, ts := range tests {
+		if lat, lon := ts.c.DecimalDegrees(); math.Abs(lat-ts.lat) > 1e-9 || math.Abs(lon-ts.lon) > 1e-9 {
+			t.Fatalf("Error TestDecimalDegrees: wrong degrees for %s (%f, %f).", ts.c, lat, lon)
+		}
+	}
+}
+
+func TestDistanceBearing(t *testing.T) {
+	tests := []struct {
+		from, to *Coordinates
+		distance float64 // m
+		bearing  float64 // °
+	}{{from: coordinates(0, 0), to: coordinates(0, 1), distance: 111195, bearing: 90},
+		{from: coordinates(0, 0), to: coordinates(1, 0), distance: 111195, bearing: 0},
+		{from: coordinates(0, 0), to: coordinates(0, -1), distance: 111195, bearing: 270},
+		{from: coordinates(1, 0), to: coordinates(0, 0), distance: 111195, bearing: 180},
+		{from: coordinates(52.5200, 13.4050), to: coordinates(48.1351, 11.5820), distance: 504200, bearing: 195.6}}
+	for _, ts := range tests {
+		if d := ts.from.Distance(ts.to); math.Abs(d-ts.distance) > ts.distance*0.001 {
+			t.Fatalf("Error TestDistanceBearing: wrong distance from %s to %s (%f m).", ts.from, ts.to, d)
+		}
+		if b := ts.from.Bearing(ts.to); math.Abs(b-ts.bearing) > 0.1 {
+			t.Fatalf("Error TestDistanceBearing: wrong bearing from %s to %s (%f °).", ts.from, ts.to, b)
+		}
+	}
+	if d := coordinates(10, 10).Distance(coordinates(10, 10)); d != 0 {
+		t.Fatalf("Error TestDistanceBearing: distance to itself is %f m.", d)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	d := &DateTime{Date: 140614, Time: 195923568}
+	want := time.Date(2014, time.June, 14, 19, 59, 23, 568*int(time.Millisecond), time.UTC)
+	if r := d.ToTime(); !r.Equal(want) {
+		t.Fatalf("Error TestDateTime: wrong time (%s != %s).", r, want)
+	}
+	d = &DateTime{Date: 10100, Time: 5007}
+	want = time.Date(2000, time.January, 1, 0, 0, 5, 7*int(time.Millisecond), time.UTC)
+	if r := d.ToTime(); !r.Equal(want) {
+		t.Fatalf("Error TestDateTime: wrong time (%s != %s).", r, want)
+	}
+}
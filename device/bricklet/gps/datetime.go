@@ -0,0 +1,81 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	"time"
+)
+
+// GetDateTime creates the subscriber to get the date and the time (UTC) of the GPS receiver.
+// For periodical values use the DateTimePeriod callback.
+func GetDateTime(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDateTime"),
+		Fid:        function_get_date_time,
+		Uid:        uid,
+		Result:     &DateTime{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDateTimeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDateTimeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*DateTime, error) {
+	return device.FutureOf[*DateTime](brick, connectorname, GetDateTime("getdatetimefuture"+device.GenId(), uid, nil))
+}
+
+/*
+DateTime is the date and the time (UTC) of the GPS receiver.
+
+The date is given in the format ddmmyy and the time in the format hhmmss|sss:
+
+	Date: 140614    - 14.06.2014
+	Time: 195923568 - 19:59:23.568
+*/
+type DateTime struct {
+	Date uint32 // ddmmyy
+	Time uint32 // hhmmss|sss
+}
+
+// FromPacket creates from a packet a DateTime.
+func (d *DateTime) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(d, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(d)
+}
+
+// String fullfill the stringer interface.
+func (d *DateTime) String() string {
+	txt := "Date Time "
+	if d == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Date: %06d, Time: %09d (%s)]", d.Date, d.Time, d.ToTime().Format("2006-01-02 15:04:05.000 MST"))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (d *DateTime) Copy() device.Resulter {
+	if d == nil {
+		return nil
+	}
+	return &DateTime{Date: d.Date, Time: d.Time}
+}
+
+// ToTime converts the date and the time into a time.Time (UTC).
+// The two digit year is interpreted as year of the 21st century.
+func (d *DateTime) ToTime() time.Time {
+	day, month, year := int(d.Date/10000), time.Month(d.Date/100%100), 2000+int(d.Date%100)
+	ms := int(d.Time % 1000)
+	hms := d.Time / 1000
+	hour, min, sec := int(hms/10000), int(hms/100%100), int(hms%100)
+	return time.Date(year, month, day, hour, min, sec, ms*int(time.Millisecond), time.UTC)
+}
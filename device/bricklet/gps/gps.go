@@ -0,0 +1,82 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the GPS Bricklet.
+package gps
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+const (
+	function_get_coordinates                 = uint8(1)
+	function_get_status                      = uint8(2)
+	function_get_altitude                    = uint8(3)
+	function_get_motion                      = uint8(4)
+	function_get_date_time                   = uint8(5)
+	function_restart                         = uint8(6)
+	function_set_coordinates_callback_period = uint8(7)
+	function_get_coordinates_callback_period = uint8(8)
+	function_set_status_callback_period      = uint8(9)
+	function_get_status_callback_period      = uint8(10)
+	function_set_altitude_callback_period    = uint8(11)
+	function_get_altitude_callback_period    = uint8(12)
+	function_set_motion_callback_period      = uint8(13)
+	function_get_motion_callback_period      = uint8(14)
+	function_set_date_time_callback_period   = uint8(15)
+	function_get_date_time_callback_period   = uint8(16)
+	callback_coordinates                     = uint8(17)
+	callback_status                          = uint8(18)
+	callback_altitude                        = uint8(19)
+	callback_motion                          = uint8(20)
+	callback_date_time                       = uint8(21)
+	// Fix
+	FixNoFix = uint8(1)
+	Fix2DFix = uint8(2)
+	Fix3DFix = uint8(3)
+	// Restart types
+	RestartHot          = uint8(0)
+	RestartWarm         = uint8(1)
+	RestartCold         = uint8(2)
+	RestartFactoryReset = uint8(3)
+)
+
+// FixName results a string representation of the given fix.
+func FixName(f uint8) string {
+	switch f {
+	case FixNoFix:
+		return "No Fix"
+	case Fix2DFix:
+		return "2D Fix"
+	case Fix3DFix:
+		return "3D Fix"
+	default:
+		return "Unknown"
+	}
+}
+
+// Restart creates the subscriber to restart the GPS receiver.
+// The restart type could be hot (RestartHot), warm (RestartWarm), cold (RestartCold)
+// or a factory reset (RestartFactoryReset), which deletes all stored data of the receiver.
+func Restart(id string, uid uint32, r *RestartType, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Restart"),
+		Fid:        function_restart,
+		Uid:        uid,
+		Data:       r,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// RestartFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func RestartFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *RestartType) error {
+	return device.FutureEmpty(brick, connectorname, Restart("restartfuture"+device.GenId(), uid, r, nil))
+}
+
+// RestartType is the type of a restart.
+type RestartType struct {
+	Value uint8
+}
@@ -0,0 +1,74 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetMotion creates the subscriber to get the course and the speed.
+// The motion is only valid, if there is a fix (see GetStatus).
+// For periodical values use the MotionPeriod callback.
+func GetMotion(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMotion"),
+		Fid:        function_get_motion,
+		Uid:        uid,
+		Result:     &Motion{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMotionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMotionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Motion, error) {
+	return device.FutureOf[*Motion](brick, connectorname, GetMotion("getmotionfuture"+device.GenId(), uid, nil))
+}
+
+// Motion is the course (1/100 °, 0° is north) and the speed (1/100 km/h) of the GPS receiver.
+type Motion struct {
+	Course uint32 // 1/100 °
+	Speed  uint32 // 1/100 km/h
+}
+
+// FromPacket creates from a packet a Motion.
+func (m *Motion) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(m, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(m)
+}
+
+// String fullfill the stringer interface.
+func (m *Motion) String() string {
+	txt := "Motion "
+	if m == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Course: %.02f °, Speed: %.02f km/h]", m.Degrees(), m.KilometersPerHour())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (m *Motion) Copy() device.Resulter {
+	if m == nil {
+		return nil
+	}
+	return &Motion{Course: m.Course, Speed: m.Speed}
+}
+
+// Degrees converts the course into °.
+func (m *Motion) Degrees() float64 {
+	return float64(m.Course) / 100.0
+}
+
+// KilometersPerHour converts the speed into km/h.
+func (m *Motion) KilometersPerHour() float64 {
+	return float64(m.Speed) / 100.0
+}
@@ -0,0 +1,260 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCoordinatesCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// CoordinatesPeriod is only triggered if the coordinates have changed since the last triggering.
+func SetCoordinatesCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCoordinatesCallbackPeriod"),
+		Fid:        function_set_coordinates_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCoordinatesCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCoordinatesCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetCoordinatesCallbackPeriod("setcoordinatescallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetCoordinatesCallbackPeriod creates a subscriber to get the callback period value.
+func GetCoordinatesCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCoordinatesCallbackPeriod"),
+		Fid:        function_get_coordinates_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCoordinatesCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCoordinatesCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetCoordinatesCallbackPeriod("getcoordinatescallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetStatusCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// StatusPeriod is only triggered if the status has changed since the last triggering.
+func SetStatusCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetStatusCallbackPeriod"),
+		Fid:        function_set_status_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetStatusCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetStatusCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetStatusCallbackPeriod("setstatuscallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetStatusCallbackPeriod creates a subscriber to get the callback period value.
+func GetStatusCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStatusCallbackPeriod"),
+		Fid:        function_get_status_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStatusCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStatusCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetStatusCallbackPeriod("getstatuscallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetAltitudeCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AltitudePeriod is only triggered if the altitude has changed since the last triggering.
+func SetAltitudeCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAltitudeCallbackPeriod"),
+		Fid:        function_set_altitude_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAltitudeCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAltitudeCallbackPeriod("setaltitudecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAltitudeCallbackPeriod creates a subscriber to get the callback period value.
+func GetAltitudeCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAltitudeCallbackPeriod"),
+		Fid:        function_get_altitude_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAltitudeCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAltitudeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAltitudeCallbackPeriod("getaltitudecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetMotionCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// MotionPeriod is only triggered if the motion has changed since the last triggering.
+func SetMotionCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMotionCallbackPeriod"),
+		Fid:        function_set_motion_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMotionCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMotionCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetMotionCallbackPeriod("setmotioncallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetMotionCallbackPeriod creates a subscriber to get the callback period value.
+func GetMotionCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMotionCallbackPeriod"),
+		Fid:        function_get_motion_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMotionCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMotionCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetMotionCallbackPeriod("getmotioncallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetDateTimeCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// DateTimePeriod is only triggered if the date and time have changed since the last triggering.
+func SetDateTimeCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetDateTimeCallbackPeriod"),
+		Fid:        function_set_date_time_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetDateTimeCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetDateTimeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetDateTimeCallbackPeriod("setdatetimecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetDateTimeCallbackPeriod creates a subscriber to get the callback period value.
+func GetDateTimeCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetDateTimeCallbackPeriod"),
+		Fid:        function_get_date_time_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetDateTimeCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetDateTimeCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetDateTimeCallbackPeriod("getdatetimecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// CoordinatesPeriod creates a subscriber for the periodical coordinates callback.
+// Is only triggered if the coordinates changed, since last triggering.
+func CoordinatesPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CoordinatesPeriod"),
+		Fid:        callback_coordinates,
+		Uid:        uid,
+		Result:     &Coordinates{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// StatusPeriod creates a subscriber for the periodical status callback.
+// Is only triggered if the status changed, since last triggering.
+func StatusPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "StatusPeriod"),
+		Fid:        callback_status,
+		Uid:        uid,
+		Result:     &Status{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AltitudePeriod creates a subscriber for the periodical altitude callback.
+// Is only triggered if the altitude changed, since last triggering.
+func AltitudePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AltitudePeriod"),
+		Fid:        callback_altitude,
+		Uid:        uid,
+		Result:     &Altitude{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// MotionPeriod creates a subscriber for the periodical motion callback.
+// Is only triggered if the motion changed, since last triggering.
+func MotionPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "MotionPeriod"),
+		Fid:        callback_motion,
+		Uid:        uid,
+		Result:     &Motion{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// DateTimePeriod creates a subscriber for the periodical date and time callback.
+// Is only triggered if the date and time changed, since last triggering.
+func DateTimePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DateTimePeriod"),
+		Fid:        callback_date_time,
+		Uid:        uid,
+		Result:     &DateTime{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,74 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gps
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetStatus creates the subscriber to get the status of the GPS receiver.
+// The status contains the fix and the number of satellites in view and in use.
+// For periodical values use the StatusPeriod callback.
+func GetStatus(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetStatus"),
+		Fid:        function_get_status,
+		Uid:        uid,
+		Result:     &Status{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetStatusFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetStatusFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Status, error) {
+	return device.FutureOf[*Status](brick, connectorname, GetStatus("getstatusfuture"+device.GenId(), uid, nil))
+}
+
+// Status is the status of the GPS receiver.
+type Status struct {
+	Fix            uint8 // FixNoFix, Fix2DFix or Fix3DFix
+	SatellitesView uint8
+	SatellitesUsed uint8
+}
+
+// FromPacket creates from a packet a Status.
+func (s *Status) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *Status) String() string {
+	txt := "Status "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Fix: %s (%d), Satellites View: %d, Satellites Used: %d]",
+			FixName(s.Fix), s.Fix, s.SatellitesView, s.SatellitesUsed)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *Status) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &Status{
+		Fix:            s.Fix,
+		SatellitesView: s.SatellitesView,
+		SatellitesUsed: s.SatellitesUsed}
+}
+
+// HasFix tests, if the receiver has a 2D or 3D fix.
+func (s *Status) HasFix() bool {
+	return s.Fix == Fix2DFix || s.Fix == Fix3DFix
+}
@@ -27,26 +27,9 @@ func GetAnalogValue(id string, uid uint32, handler func(device.Resulter, error))
 }
 
 // GetAnalogValueFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetAnalogValueFuture(brick bricker.Bricker, connectorname string, uid uint32) *AnalogValue {
-	future := make(chan *AnalogValue)
-	defer close(future)
-	sub := GetAnalogValue("getanalogvaluefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *AnalogValue = nil
-			if err == nil {
-				if value, ok := r.(*AnalogValue); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AnalogValue, error) {
+	return device.FutureOf[*AnalogValue](brick, connectorname, GetAnalogValue("getanalogvaluefuture"+device.GenId(), uid, nil))
 }
 
 /*
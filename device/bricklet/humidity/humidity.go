@@ -44,25 +44,9 @@ func GetHumidity(id string, uid uint32, handler func(device.Resulter, error)) *d
 }
 
 // GetHumidityFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetHumidityFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Humidity {
-	future := make(chan *Humidity)
-	defer close(future)
-	sub := GetHumidity("gethumidityfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Humidity = nil
-			if err == nil {
-				if value, ok := r.(*Humidity); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetHumidityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Humidity, error) {
+	return device.FutureOf[*Humidity](brick, connectorname, GetHumidity("gethumidityfuture"+device.GenId(), uid, nil))
 }
 
 /*
@@ -0,0 +1,148 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdigitalin4
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetGroup creates the subscriber to set the group of the inputs.
+// Up to four Bricklets of this type can be combined (grouped) to one device with up to 16 pins.
+// The group is a list of the ports ('a' - 'd') with the Bricklets, which should be grouped.
+// A 'n' marks a unused position in the group.
+//
+// All other functions, which use bitmasks, are working with the grouped pins, the
+// first four pins belongs to the first port of the group, the next four to the second and so on.
+// Default is {'n', 'n', 'n', 'n'} (no group).
+func SetGroup(id string, uid uint32, g *Group, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetGroup"),
+		Fid:        function_set_group,
+		Uid:        uid,
+		Data:       g,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetGroupFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetGroupFuture(brick *bricker.Bricker, connectorname string, uid uint32, g *Group) error {
+	return device.FutureEmpty(brick, connectorname, SetGroup("setgroupfuture"+device.GenId(), uid, g, nil))
+}
+
+// GetGroup creates the subscriber to get the group, which was set with SetGroup.
+func GetGroup(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetGroup"),
+		Fid:        function_get_group,
+		Uid:        uid,
+		Result:     &Group{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetGroupFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetGroupFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Group, error) {
+	return device.FutureOf[*Group](brick, connectorname, GetGroup("getgroupfuture"+device.GenId(), uid, nil))
+}
+
+// GetAvailableForGroup creates the subscriber to get a bitmask of the ports, which could be used for a group.
+// Bit 0 is port 'a', bit 1 is port 'b' and so on.
+// A port is available, if a Bricklet of this type is connected.
+func GetAvailableForGroup(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAvailableForGroup"),
+		Fid:        function_get_available_for_group,
+		Uid:        uid,
+		Result:     &Available{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAvailableForGroupFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAvailableForGroupFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Available, error) {
+	return device.FutureOf[*Available](brick, connectorname, GetAvailableForGroup("getavailableforgroupfuture"+device.GenId(), uid, nil))
+}
+
+// Group is the type for the group of Bricklets.
+// Every entry is a port ('a' - 'd') or GroupNone ('n').
+type Group struct {
+	Value [4]byte
+}
+
+// NewGroup creates a group of the given ports, the unused positions are filled with GroupNone.
+// More than four ports are ignored.
+func NewGroup(ports ...byte) *Group {
+	g := &Group{Value: [4]byte{GroupNone, GroupNone, GroupNone, GroupNone}}
+	for i := 0; i < len(ports) && i < len(g.Value); i++ {
+		g.Value[i] = ports[i]
+	}
+	return g
+}
+
+// FromPacket creates a Group from a packet.
+func (g *Group) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(g, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(g)
+}
+
+// String fullfill the stringer interface.
+func (g *Group) String() string {
+	txt := "Group "
+	if g == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[%c, %c, %c, %c]", g.Value[0], g.Value[1], g.Value[2], g.Value[3])
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (g *Group) Copy() device.Resulter {
+	if g == nil {
+		return nil
+	}
+	return &Group{Value: g.Value}
+}
+
+// Available is the bitmask (4bit) of the ports, which could be used for a group.
+type Available struct {
+	Mask uint8
+}
+
+// FromPacket creates a Available from a packet.
+func (a *Available) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(a, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(a)
+}
+
+// String fullfill the stringer interface.
+func (a *Available) String() string {
+	txt := "Available "
+	if a == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Mask: %d (%s)]", a.Mask, misc.MaskToString(a.Mask, 4, true))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (a *Available) Copy() device.Resulter {
+	if a == nil {
+		return nil
+	}
+	return &Available{Mask: a.Mask}
+}
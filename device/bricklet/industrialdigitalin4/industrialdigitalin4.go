@@ -0,0 +1,32 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Industrial Digital In 4 Bricklet.
+package industrialdigitalin4
+
+const (
+	function_get_value               = uint8(1)
+	function_set_group               = uint8(2)
+	function_get_group               = uint8(3)
+	function_get_available_for_group = uint8(4)
+	function_set_debounce_period     = uint8(5)
+	function_get_debounce_period     = uint8(6)
+	function_set_interrupt           = uint8(7)
+	function_get_interrupt           = uint8(8)
+	function_get_edge_count          = uint8(10)
+	function_set_edge_count_config   = uint8(11)
+	function_get_edge_count_config   = uint8(12)
+	callback_interrupt               = uint8(9)
+	// Group
+	GroupNone = byte('n') // unused position in a group
+	// Edge count types
+	EdgeCountType_Rising  = uint8(0) // default
+	EdgeCountType_Falling = uint8(1)
+	EdgeCountType_Both    = uint8(2)
+)
+
+// Pin is a type to select a special Pin (0-15, 0-3 without a group).
+type Pin struct {
+	Value uint8
+}
@@ -0,0 +1,64 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdigitalin4
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// GetValue creates the subscriber to get the input value bitmask.
+// A 1 in the bitmask means high and a 0 in the bitmask means low.
+// Without a group only the lower 4 bit are used.
+func GetValue(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetValue"),
+		Fid:        function_get_value,
+		Uid:        uid,
+		Result:     &Value{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetValueFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Value, error) {
+	return device.FutureOf[*Value](brick, connectorname, GetValue("getvaluefuture"+device.GenId(), uid, nil))
+}
+
+// Value is the type for the value bitmask (16bit, 4bit without a group).
+type Value struct {
+	Mask uint16
+}
+
+// FromPacket creates a Value from a packet.
+func (v *Value) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Value) String() string {
+	txt := "Value "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Mask: %d (%s)]", v.Mask, misc.Mask16ToString(v.Mask, 16, true))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Value) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Value{Mask: v.Mask}
+}
@@ -0,0 +1,112 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdigitalout4
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetMonoflop creates the subscriber to set the monoflop timer value for the specified pins (per bitmask).
+// The selected pins are set to the value of the value mask and
+// after the time (ms) they are changed back.
+func SetMonoflop(id string, uid uint32, m *Monoflops, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetMonoflop"),
+		Fid:        function_set_monoflop,
+		Uid:        uid,
+		Data:       m,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetMonoflopFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Monoflops) error {
+	return device.FutureEmpty(brick, connectorname, SetMonoflop("setmonoflopfuture"+device.GenId(), uid, m, nil))
+}
+
+// GetMonoflop creates a subscriber for getting the actual monoflop value of a pin.
+func GetMonoflop(id string, uid uint32, pin *Pin, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetMonoflop"),
+		Fid:        function_get_monoflop,
+		Uid:        uid,
+		Result:     &Monoflop{},
+		Data:       pin,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetMonoflopFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, pin *Pin) (*Monoflop, error) {
+	return device.FutureOf[*Monoflop](brick, connectorname, GetMonoflop("getmonoflopfuture"+device.GenId(), uid, pin, nil))
+}
+
+/*
+MonoflopDone creates a subscriber for the monoflop done callback.
+This callback is triggered whenever a monoflop timer reaches 0.
+The response values contain the involved pins and the current value of the pins
+(the value after the monoflop).
+*/
+func MonoflopDone(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "MonoflopDone"),
+		Fid:        callback_monoflop_done,
+		Uid:        uid,
+		Result:     &Values{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Monoflops is a type to set bitmask (16bit) based the time to hold the value.
+// The time is given in ms.
+type Monoflops struct {
+	SelectionMask uint16 // Bitmask (16bit)
+	ValueMask     uint16 // Bitmask (16bit)
+	Time          uint32 // ms
+}
+
+// Monoflop is the monoflop timer value of a specified pin.
+type Monoflop struct {
+	Value         uint16
+	Time          uint32 // in ms
+	TimeRemaining uint32 // in ms
+}
+
+// FromPacket creates a Monoflop from a packet.
+func (m *Monoflop) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(m, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(m)
+}
+
+// String fullfill the stringer interface.
+func (m *Monoflop) String() string {
+	txt := "Monoflop "
+	if m == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Time: %d ms, Time Remaining: %d ms]",
+			m.Value, m.Time, m.TimeRemaining)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (m *Monoflop) Copy() device.Resulter {
+	if m == nil {
+		return nil
+	}
+	return &Monoflop{
+		Value:         m.Value,
+		Time:          m.Time,
+		TimeRemaining: m.TimeRemaining}
+}
@@ -0,0 +1,139 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdigitalout4
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetValue creates the subscriber to set the output value with a bitmask (high or low).
+// A 1 in the bitmask means high and a 0 in the bitmask means low.
+// Without a group only the lower 4 bit are used.
+//
+// If no groups are used, SetSelectedValues could be used to set only some pins.
+func SetValue(id string, uid uint32, v *Value, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetValue"),
+		Fid:        function_set_value,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetValueFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Value) error {
+	return device.FutureEmpty(brick, connectorname, SetValue("setvaluefuture"+device.GenId(), uid, v, nil))
+}
+
+// GetValue creates the subscriber to get the output value bitmask.
+func GetValue(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetValue"),
+		Fid:        function_get_value,
+		Uid:        uid,
+		Result:     &Value{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetValueFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Value, error) {
+	return device.FutureOf[*Value](brick, connectorname, GetValue("getvaluefuture"+device.GenId(), uid, nil))
+}
+
+// SetSelectedValues creates a subscriber for setting values per bitmask (16bit).
+// Only the pins of the selection mask are changed, all other pins remain untouched.
+func SetSelectedValues(id string, uid uint32, v *Values, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSelectedValues"),
+		Fid:        function_set_selected_values,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSelectedValuesFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSelectedValuesFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Values) error {
+	return device.FutureEmpty(brick, connectorname, SetSelectedValues("setselectedvaluesfuture"+device.GenId(), uid, v, nil))
+}
+
+// Value is the type for the value bitmask (16bit, 4bit without a group).
+type Value struct {
+	Mask uint16
+}
+
+// FromPacket creates a Value from a packet.
+func (v *Value) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Value) String() string {
+	txt := "Value "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Mask: %d (%s)]", v.Mask, misc.Mask16ToString(v.Mask, 16, true))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Value) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Value{Mask: v.Mask}
+}
+
+// Values is a type for setting or getting values.
+// The bitmasks are 16bit wide (4bit without a group).
+type Values struct {
+	SelectionMask uint16
+	ValueMask     uint16
+}
+
+// FromPacket creates the values bitmasks from a packet.
+func (v *Values) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Values) String() string {
+	txt := "Values "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Selection Mask: %d (%s), Value Mask: %d (%s)]",
+			v.SelectionMask, misc.Mask16ToString(v.SelectionMask, 16, true),
+			v.ValueMask, misc.Mask16ToString(v.ValueMask, 16, true))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Values) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Values{
+		SelectionMask: v.SelectionMask,
+		ValueMask:     v.ValueMask}
+}
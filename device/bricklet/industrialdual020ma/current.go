@@ -0,0 +1,129 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetCurrent creates the subscriber to get the current (nA) of the sensor (0 or 1).
+// For periodical values use the CurrentPeriod callback.
+func GetCurrent(id string, uid uint32, sensor uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrent"),
+		Fid:        function_get_current,
+		Uid:        uid,
+		Result:     &Current{},
+		Data:       sensor,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentFuture(brick *bricker.Bricker, connectorname string, uid uint32, sensor uint8) (*Current, error) {
+	return device.FutureOf[*Current](brick, connectorname, GetCurrent("getcurrentfuture"+device.GenId(), uid, sensor, nil))
+}
+
+// CurrentPeriod creates a subscriber for the periodical current callback.
+// The callback is triggered for every sensor with his own period (see SetCurrentCallbackPeriod).
+// Is only triggered if the current of the sensor changed, since last triggering.
+func CurrentPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CurrentPeriod"),
+		Fid:        callback_current,
+		Uid:        uid,
+		Result:     &SensorCurrent{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// CurrentReached creates a subscriber for the current threshold callback.
+// The callback is triggered, if the threshold of a sensor is reached (see SetCurrentCallbackThreshold).
+// The period between two callbacks is the debounce period (see SetDebouncePeriod).
+func CurrentReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CurrentReached"),
+		Fid:        callback_current_reached,
+		Uid:        uid,
+		Result:     &SensorCurrent{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Current is the current of a sensor in nA (0 - 22505322 nA).
+type Current struct {
+	Value int32 // nA
+}
+
+// FromPacket creates from a packet a Current.
+func (c *Current) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// Float64 converts the current from nA into mA.
+func (c *Current) Float64() float64 {
+	return float64(c.Value) / 1000000.0
+}
+
+// String fullfill the stringer interface.
+func (c *Current) String() string {
+	txt := "Current "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d nA, Current: %.3f mA]", c.Value, c.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Current) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Current{Value: c.Value}
+}
+
+// SensorCurrent is the result of the current callbacks with the sensor and his current.
+type SensorCurrent struct {
+	Sensor uint8
+	Current
+}
+
+// FromPacket creates from a packet a SensorCurrent.
+func (sc *SensorCurrent) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(sc, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(sc)
+}
+
+// String fullfill the stringer interface.
+func (sc *SensorCurrent) String() string {
+	txt := "Sensor Current "
+	if sc == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Sensor: %d, Value: %d nA, Current: %.3f mA]", sc.Sensor, sc.Value, sc.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (sc *SensorCurrent) Copy() device.Resulter {
+	if sc == nil {
+		return nil
+	}
+	return &SensorCurrent{Sensor: sc.Sensor, Current: Current{Value: sc.Value}}
+}
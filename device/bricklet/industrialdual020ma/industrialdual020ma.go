@@ -0,0 +1,44 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Industrial Dual 0-20mA Bricklet.
+package industrialdual020ma
+
+const (
+	function_get_current                    = uint8(1)
+	function_set_current_callback_period    = uint8(2)
+	function_get_current_callback_period    = uint8(3)
+	function_set_current_callback_threshold = uint8(4)
+	function_get_current_callback_threshold = uint8(5)
+	function_set_debounce_period            = uint8(6)
+	function_get_debounce_period            = uint8(7)
+	function_set_sample_rate                = uint8(8)
+	function_get_sample_rate                = uint8(9)
+	function_is_sensor_connected            = uint8(10)
+	callback_current                        = uint8(11)
+	callback_current_reached                = uint8(12)
+	// Sensors
+	SensorCount = uint8(2) // number of sensors (0 and 1)
+	// Sample rates
+	SampleRate240SPS = uint8(0)
+	SampleRate60SPS  = uint8(1)
+	SampleRate15SPS  = uint8(2)
+	SampleRate4SPS   = uint8(3) // default
+)
+
+// SampleRateName results a string representation of the given sample rate.
+func SampleRateName(r uint8) string {
+	switch r {
+	case SampleRate240SPS:
+		return "240 samples per second"
+	case SampleRate60SPS:
+		return "60 samples per second"
+	case SampleRate15SPS:
+		return "15 samples per second"
+	case SampleRate4SPS:
+		return "4 samples per second"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCurrentCallbackPeriod creates the subscriber to set the callback period of a sensor.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// CurrentPeriod is only triggered if the current of the sensor has changed since the last triggering.
+func SetCurrentCallbackPeriod(id string, uid uint32, sp *SensorPeriod, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentCallbackPeriod"),
+		Fid:        function_set_current_callback_period,
+		Uid:        uid,
+		Data:       sp,
+		Handler:    handler,
+		Replay:     true,
+		ReplayKey:  1, // sensor
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, sp *SensorPeriod) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentCallbackPeriod("setcurrentcallbackperiodfuture"+device.GenId(), uid, sp, nil))
+}
+
+// GetCurrentCallbackPeriod creates a subscriber to get the callback period value of a sensor.
+func GetCurrentCallbackPeriod(id string, uid uint32, sensor uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentCallbackPeriod"),
+		Fid:        function_get_current_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Data:       sensor,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, sensor uint8) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetCurrentCallbackPeriod("getcurrentcallbackperiodfuture"+device.GenId(), uid, sensor, nil))
+}
+
+// SensorPeriod is the callback period (ms) of a sensor.
+type SensorPeriod struct {
+	Sensor uint8
+	device.Period
+}
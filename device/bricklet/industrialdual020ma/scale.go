@@ -0,0 +1,70 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+Scale converts the loop current of a sensor into engineering units (e.g. bar or °C).
+
+The current From (mA) is the value Min and the current To (mA) is the value Max,
+between them the value is interpolated linear.
+A transmitter with a range of 0 up to 10 bar on a 4-20mA loop is
+
+	s := industrialdual020ma.NewLoopScale(0, 10)
+	c, err := industrialdual020ma.GetCurrentFuture(brick, "local", uid, 0)
+	bar := s.Value(c)
+*/
+type Scale struct {
+	From float64 // mA
+	To   float64 // mA
+	Min  float64 // value at From
+	Max  float64 // value at To
+}
+
+// NewLoopScale creates a scale for a 4-20mA loop with the range min up to max.
+func NewLoopScale(min, max float64) *Scale {
+	return &Scale{From: 4, To: 20, Min: min, Max: max}
+}
+
+// Value converts the current into the engineering unit.
+// A current outside the range From up to To is extrapolated.
+func (s *Scale) Value(c *Current) float64 {
+	if s.To == s.From {
+		return s.Min
+	}
+	return s.Min + (c.Float64()-s.From)*(s.Max-s.Min)/(s.To-s.From)
+}
+
+// InRange tests, if the current is inside the range From up to To.
+// On a 4-20mA loop a current below 4mA means a broken wire or a failure of the sensor.
+func (s *Scale) InRange(c *Current) bool {
+	ma := c.Float64()
+	return ma >= math.Min(s.From, s.To) && ma <= math.Max(s.From, s.To)
+}
+
+// Current converts a value of the engineering unit back into the current,
+// e.g. for setting a threshold (see SetCurrentCallbackThreshold).
+func (s *Scale) Current(v float64) *Current {
+	if s.Max == s.Min {
+		return &Current{Value: int32(math.Round(s.From * 1000000.0))}
+	}
+	ma := s.From + (v-s.Min)*(s.To-s.From)/(s.Max-s.Min)
+	return &Current{Value: int32(math.Round(ma * 1000000.0))}
+}
+
+// String fullfill the stringer interface.
+func (s *Scale) String() string {
+	txt := "Scale "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[%.3f mA - %.3f mA: %g - %g]", s.From, s.To, s.Min, s.Max)
+	}
+	return txt
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScaleValue(t *testing.T) {
+	s := NewLoopScale(0, 10)
+	tests := []struct {
+		current int32 // nA
+		value   float64
+		inrange bool
+	}{{current: 4000000, value: 0, inrange: true},
+		{current: 12000000, value: 5, inrange: true},
+		{current: 20000000, value: 10, inrange: true},
+		{current: 2000000, value: -1.25, inrange: false},
+		{current: 21000000, value: 10.625, inrange: false}}
+	for _, ts := range tests {
+		c := &Current{Value: ts.current}
+		if v := s.Value(c); math.Abs(v-ts.value) > 1e-9 {
+			t.Fatalf("Error TestScaleValue: wrong value for %s (%f != %f).", c, v, ts.value)
+		}
+		if r := s.InRange(c); r != ts.inrange {
+			t.Fatalf("Error TestScaleValue: wrong range test for %s (%t).", c, r)
+		}
+	}
+	inverse := &Scale{From: 0, To: 20, Min: 100, Max: -100}
+	if v := inverse.Value(&Current{Value: 5000000}); math.Abs(v-50) > 1e-9 {
+		t.Fatalf("Error TestScaleValue: wrong value of the inverse scale (%f).", v)
+	}
+	if v := (&Scale{From: 4, To: 4, Min: 3}).Value(&Current{Value: 8000000}); v != 3 {
+		t.Fatalf("Error TestScaleValue: wrong value of a empty range (%f).", v)
+	}
+}
+
+func TestScaleCurrent(t *testing.T) {
+	s := NewLoopScale(-50, 150)
+	for _, v := range []float64{-50, 0, 42.5, 150, 200} {
+		c := s.Current(v)
+		if r := s.Value(c); math.Abs(r-v) > 1e-3 {
+			t.Fatalf("Error TestScaleCurrent: %f converts to %s and back to %f.", v, c, r)
+		}
+	}
+	if c := s.Current(50); c.Value != 12000000 {
+		t.Fatalf("Error TestScaleCurrent: wrong current (%s).", c)
+	}
+}
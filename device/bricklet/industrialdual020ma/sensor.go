@@ -0,0 +1,160 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetSampleRate creates the subscriber to set the sample rate of the sensors.
+// A lower sample rate results in a better resolution (18bit at 4 samples per second).
+// Default value is 4 samples per second (SampleRate4SPS).
+func SetSampleRate(id string, uid uint32, sr *SampleRate, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSampleRate"),
+		Fid:        function_set_sample_rate,
+		Uid:        uid,
+		Data:       sr,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSampleRateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSampleRateFuture(brick *bricker.Bricker, connectorname string, uid uint32, sr *SampleRate) error {
+	return device.FutureEmpty(brick, connectorname, SetSampleRate("setsampleratefuture"+device.GenId(), uid, sr, nil))
+}
+
+// GetSampleRate creates the subscriber to get the sample rate.
+func GetSampleRate(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSampleRate"),
+		Fid:        function_get_sample_rate,
+		Uid:        uid,
+		Result:     &SampleRate{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSampleRateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSampleRateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*SampleRate, error) {
+	return device.FutureOf[*SampleRate](brick, connectorname, GetSampleRate("getsampleratefuture"+device.GenId(), uid, nil))
+}
+
+// IsSensorConnected creates the subscriber to test, if a sensor is connected.
+// A sensor is connected, if the current is at least 0.3mA.
+func IsSensorConnected(id string, uid uint32, sensor uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsSensorConnected"),
+		Fid:        function_is_sensor_connected,
+		Uid:        uid,
+		Result:     &Connected{},
+		Data:       sensor,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsSensorConnectedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsSensorConnectedFuture(brick *bricker.Bricker, connectorname string, uid uint32, sensor uint8) (*Connected, error) {
+	return device.FutureOf[*Connected](brick, connectorname, IsSensorConnected("issensorconnectedfuture"+device.GenId(), uid, sensor, nil))
+}
+
+// IsSensorConnectedFutureSimple calls the IsSensorConnectedFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsSensorConnectedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32, sensor uint8) (bool, error) {
+	c, err := IsSensorConnectedFuture(brick, connectorname, uid, sensor)
+	if err != nil {
+		return false, err
+	}
+	return c.Value, nil
+}
+
+// SampleRate is the sample rate of the sensors.
+type SampleRate struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a SampleRate.
+func (sr *SampleRate) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(sr, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(sr)
+}
+
+// String fullfill the stringer interface.
+func (sr *SampleRate) String() string {
+	txt := "Sample Rate "
+	if sr == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", SampleRateName(sr.Value), sr.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (sr *SampleRate) Copy() device.Resulter {
+	if sr == nil {
+		return nil
+	}
+	return &SampleRate{Value: sr.Value}
+}
+
+// Connected is the state of the sensor connection.
+type Connected struct {
+	Value bool // true - connected, false - not connected
+}
+
+// FromPacket converts the packet payload to the Connected type.
+func (c *Connected) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	cr := new(ConnectedRaw)
+	err := p.Payload.Decode(cr)
+	if err == nil {
+		c.FromConnectedRaw(cr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (c *Connected) String() string {
+	txt := "Connected "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Connected) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Connected{Value: c.Value}
+}
+
+// FromConnectedRaw converts the ConnectedRaw into a Connected.
+func (c *Connected) FromConnectedRaw(cr *ConnectedRaw) {
+	if c == nil || cr == nil {
+		return
+	}
+	c.Value = misc.Uint8ToBool(cr.Value)
+}
+
+// ConnectedRaw is the real de/encoding type for a Connected.
+type ConnectedRaw struct {
+	Value uint8
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package industrialdual020ma
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCurrentCallbackThreshold creates the subscriber to set the callback thresold of a sensor.
+// Default value is ('x', 0, 0).
+func SetCurrentCallbackThreshold(id string, uid uint32, st *SensorThreshold, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentCallbackThreshold"),
+		Fid:        function_set_current_callback_threshold,
+		Uid:        uid,
+		Data:       st,
+		Handler:    handler,
+		Replay:     true,
+		ReplayKey:  1, // sensor
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, st *SensorThreshold) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentCallbackThreshold("setcurrentcallbackthresholdfuture"+device.GenId(), uid, st, nil))
+}
+
+// GetCurrentCallbackThreshold creates the subscriber to get the callback thresold of a sensor.
+func GetCurrentCallbackThreshold(id string, uid uint32, sensor uint8, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentCallbackThreshold"),
+		Fid:        function_get_current_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Data:       sensor,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, sensor uint8) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetCurrentCallbackThreshold("getcurrentcallbackthresholdfuture"+device.GenId(), uid, sensor, nil))
+}
+
+// SensorThreshold is the threshold (nA) of a sensor.
+type SensorThreshold struct {
+	Sensor uint8
+	device.Threshold32
+}
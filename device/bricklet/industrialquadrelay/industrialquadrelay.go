@@ -0,0 +1,25 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Industrial Quad Relay Bricklet.
+package industrialquadrelay
+
+const (
+	function_set_value               = uint8(1)
+	function_get_value               = uint8(2)
+	function_set_monoflop            = uint8(3)
+	function_get_monoflop            = uint8(4)
+	function_set_group               = uint8(5)
+	function_get_group               = uint8(6)
+	function_get_available_for_group = uint8(7)
+	function_set_selected_values     = uint8(9)
+	callback_monoflop_done           = uint8(8)
+	// Group
+	GroupNone = byte('n') // unused position in a group
+)
+
+// Relay is a type to select a special relay (0-15, 0-3 without a group).
+type Relay struct {
+	Value uint8
+}
@@ -27,19 +27,9 @@ func SetPortConfiguration(id string, uid uint32, c *Configuration, handler func(
 }
 
 // SetPortConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetPortConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Configuration) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetPortConfiguration("setportconfigurationfuture"+device.GenId(), uid, c,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetPortConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Configuration) error {
+	return device.FutureEmpty(brick, connectorname, SetPortConfiguration("setportconfigurationfuture"+device.GenId(), uid, c, nil))
 }
 
 // GetPortConfiguration creates the subscriber to get the configuration of all pins.
@@ -57,25 +47,9 @@ func GetPortConfiguration(id string, uid uint32, po *Port, handler func(device.R
 }
 
 // GetPortConfigurationFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetPortConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) *Configurations {
-	future := make(chan *Configurations)
-	defer close(future)
-	sub := GetPortConfiguration("getportconfigurationfuture"+device.GenId(), uid, po,
-		func(r device.Resulter, err error) {
-			var v *Configurations = nil
-			if err == nil {
-				if value, ok := r.(*Configurations); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetPortConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) (*Configurations, error) {
+	return device.FutureOf[*Configurations](brick, connectorname, GetPortConfiguration("getportconfigurationfuture"+device.GenId(), uid, po, nil))
 }
 
 // Configuration is a type to set the direction and the value of the specified pin(s).
@@ -27,25 +27,9 @@ func GetEdgeCount(id string, uid uint32, ec *EdgeCount, handler func(device.Resu
 }
 
 // GetEdgeCountFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetEdgeCountFuture(brick *bricker.Bricker, connectorname string, uid uint32, ec *EdgeCount) *EdgeCounts {
-	future := make(chan *EdgeCounts)
-	defer close(future)
-	sub := GetEdgeCount("getedgecountfuture"+device.GenId(), uid, ec,
-		func(r device.Resulter, err error) {
-			var v *EdgeCounts = nil
-			if err == nil {
-				if value, ok := r.(*EdgeCounts); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetEdgeCountFuture(brick *bricker.Bricker, connectorname string, uid uint32, ec *EdgeCount) (*EdgeCounts, error) {
+	return device.FutureOf[*EdgeCounts](brick, connectorname, GetEdgeCount("getedgecountfuture"+device.GenId(), uid, ec, nil))
 }
 
 // SetEdgeCountConfig creates the subscriber to configure the edge counter for the selected pins.
@@ -63,19 +47,9 @@ func SetEdgeCountConfig(id string, uid uint32, e *EdgeCountConfigs, handler func
 }
 
 // SetEdgeCountConfigFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetEdgeCountConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *EdgeCountConfigs) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetEdgeCountConfig("setedgecountconfigfuture"+device.GenId(), uid, e,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetEdgeCountConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *EdgeCountConfigs) error {
+	return device.FutureEmpty(brick, connectorname, SetEdgeCountConfig("setedgecountconfigfuture"+device.GenId(), uid, e, nil))
 }
 
 // GetEdgeCountConfig creates a subscriber for getting the actual edge count configurations.
@@ -91,25 +65,9 @@ func GetEdgeCountConfig(id string, uid uint32, pin *Pin, handler func(device.Res
 }
 
 // GetEdgeCountConfigFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetEdgeCountConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, pin *Pin) *EdgeCountConfig {
-	future := make(chan *EdgeCountConfig)
-	defer close(future)
-	sub := GetEdgeCountConfig("getedgecountconfigfuture"+device.GenId(), uid, pin,
-		func(r device.Resulter, err error) {
-			var v *EdgeCountConfig = nil
-			if err == nil {
-				if value, ok := r.(*EdgeCountConfig); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetEdgeCountConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, pin *Pin) (*EdgeCountConfig, error) {
+	return device.FutureOf[*EdgeCountConfig](brick, connectorname, GetEdgeCountConfig("getedgecountconfigfuture"+device.GenId(), uid, pin, nil))
 }
 
 // EdgeCount is the type for GetEdgeCount.
@@ -24,19 +24,9 @@ func SetPortInterrupt(id string, uid uint32, pi *PortInterrupt, handler func(dev
 }
 
 // SetPortInterruptFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetPortInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, pi *PortInterrupt) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetPortInterrupt("setportinterruptfuture"+device.GenId(), uid, pi,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetPortInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, pi *PortInterrupt) error {
+	return device.FutureEmpty(brick, connectorname, SetPortInterrupt("setportinterruptfuture"+device.GenId(), uid, pi, nil))
 }
 
 // GetPortInterrupt creates the subscriber to get the interrupt bitmask for a port.
@@ -52,25 +42,9 @@ func GetPortInterrupt(id string, uid uint32, po *Port, handler func(device.Resul
 }
 
 // GetPortInterruptFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetPortInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) *Interrupt {
-	future := make(chan *Interrupt)
-	defer close(future)
-	sub := GetPortInterrupt("getinterruptfuture"+device.GenId(), uid, po,
-		func(r device.Resulter, err error) {
-			var v *Interrupt = nil
-			if err == nil {
-				if value, ok := r.(*Interrupt); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetPortInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) (*Interrupt, error) {
+	return device.FutureOf[*Interrupt](brick, connectorname, GetPortInterrupt("getinterruptfuture"+device.GenId(), uid, po, nil))
 }
 
 // InterruptTrigger creates a subscriber for the interrupt callback.
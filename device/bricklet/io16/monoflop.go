@@ -24,19 +24,9 @@ func SetPortMonoflop(id string, uid uint32, m *Monoflops, handler func(device.Re
 }
 
 // SetPortMonoflopFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetPortMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Monoflops) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetPortMonoflop("setportmonoflopfuture"+device.GenId(), uid, m,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetPortMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Monoflops) error {
+	return device.FutureEmpty(brick, connectorname, SetPortMonoflop("setportmonoflopfuture"+device.GenId(), uid, m, nil))
 }
 
 // GetMonoflop creates a subscriber for getting the actual monoflop value.
@@ -53,25 +43,9 @@ func GetPortMonoflop(id string, uid uint32, pp *PortPin, handler func(device.Res
 }
 
 // GetPortMonoflopFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetPortMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, pp *PortPin) *Monoflop {
-	future := make(chan *Monoflop)
-	defer close(future)
-	sub := GetPortMonoflop("getportmonoflopfuture"+device.GenId(), uid, pp,
-		func(r device.Resulter, err error) {
-			var v *Monoflop = nil
-			if err == nil {
-				if value, ok := r.(*Monoflop); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetPortMonoflopFuture(brick *bricker.Bricker, connectorname string, uid uint32, pp *PortPin) (*Monoflop, error) {
+	return device.FutureOf[*Monoflop](brick, connectorname, GetPortMonoflop("getportmonoflopfuture"+device.GenId(), uid, pp, nil))
 }
 
 /*
@@ -29,19 +29,9 @@ func SetPort(id string, uid uint32, pv *PortValue, handler func(device.Resulter,
 }
 
 // SetPortFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetPortFuture(brick *bricker.Bricker, connectorname string, uid uint32, pv *PortValue) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetPort("setportinterruptfuture"+device.GenId(), uid, pv,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetPortFuture(brick *bricker.Bricker, connectorname string, uid uint32, pv *PortValue) error {
+	return device.FutureEmpty(brick, connectorname, SetPort("setportinterruptfuture"+device.GenId(), uid, pv, nil))
 }
 
 // GetPort creates a subscriber to get the value bitmask (8bit) for a port.
@@ -58,25 +48,9 @@ func GetPort(id string, uid uint32, po *Port, handler func(device.Resulter, erro
 }
 
 // GetPortFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetPortFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) *Value {
-	future := make(chan *Value)
-	defer close(future)
-	sub := GetPort("getportfuture"+device.GenId(), uid, po,
-		func(r device.Resulter, err error) {
-			var v *Value = nil
-			if err == nil {
-				if value, ok := r.(*Value); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetPortFuture(brick *bricker.Bricker, connectorname string, uid uint32, po *Port) (*Value, error) {
+	return device.FutureOf[*Value](brick, connectorname, GetPort("getportfuture"+device.GenId(), uid, po, nil))
 }
 
 /*
@@ -26,19 +26,9 @@ func SetConfiguration(id string, uid uint32, c *Configuration, handler func(devi
 }
 
 // SetConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Configuration) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetConfiguration("setconfigurationfuture"+device.GenId(), uid, c,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Configuration) error {
+	return device.FutureEmpty(brick, connectorname, SetConfiguration("setconfigurationfuture"+device.GenId(), uid, c, nil))
 }
 
 // GetConfiguration creates the subscriber to get the configuration of all pins.
@@ -55,25 +45,9 @@ func GetConfiguration(id string, uid uint32, handler func(device.Resulter, error
 }
 
 // GetConfigurationFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Configurations {
-	future := make(chan *Configurations)
-	defer close(future)
-	sub := GetConfiguration("getconfigurationfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Configurations = nil
-			if err == nil {
-				if value, ok := r.(*Configurations); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Configurations, error) {
+	return device.FutureOf[*Configurations](brick, connectorname, GetConfiguration("getconfigurationfuture"+device.GenId(), uid, nil))
 }
 
 // Configuration is a type to set the direction and the value of the specified pin(s).
@@ -24,19 +24,9 @@ func SetInterrupt(id string, uid uint32, i *Interrupt, handler func(device.Resul
 }
 
 // SetInterruptFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, i *Interrupt) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetInterrupt("setinterruptfuture"+device.GenId(), uid, i,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32, i *Interrupt) error {
+	return device.FutureEmpty(brick, connectorname, SetInterrupt("setinterruptfuture"+device.GenId(), uid, i, nil))
 }
 
 // GetInterrupt creates the subscriber to get the interrupt bitmask.
@@ -51,25 +41,9 @@ func GetInterrupt(id string, uid uint32, handler func(device.Resulter, error)) *
 }
 
 // GetInterruptFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Interrupt {
-	future := make(chan *Interrupt)
-	defer close(future)
-	sub := GetInterrupt("getinterruptfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Interrupt = nil
-			if err == nil {
-				if value, ok := r.(*Interrupt); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetInterruptFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Interrupt, error) {
+	return device.FutureOf[*Interrupt](brick, connectorname, GetInterrupt("getinterruptfuture"+device.GenId(), uid, nil))
 }
 
 // InterruptTrigger creates a subscriber for the interrupt callback.
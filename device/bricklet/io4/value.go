@@ -29,19 +29,9 @@ func SetValue(id string, uid uint32, v *Value, handler func(device.Resulter, err
 }
 
 // SetValueFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Value) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetValue("setvaluefuture"+device.GenId(), uid, v,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Value) error {
+	return device.FutureEmpty(brick, connectorname, SetValue("setvaluefuture"+device.GenId(), uid, v, nil))
 }
 
 // GetValue creates the subscriber to get the output value.
@@ -56,25 +46,9 @@ func GetValue(id string, uid uint32, handler func(device.Resulter, error)) *devi
 }
 
 // GetValueFuture is a future pattern version for a synchronized all of the subscriber.
-// If an error occur, the result is nil.
-func GetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Value {
-	future := make(chan *Value)
-	defer close(future)
-	sub := GetValue("getvaluefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Value = nil
-			if err == nil {
-				if value, ok := r.(*Value); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Value, error) {
+	return device.FutureOf[*Value](brick, connectorname, GetValue("getvaluefuture"+device.GenId(), uid, nil))
 }
 
 // SetSelectedValues creates a subscriber for setting values per bitmap (4bit).
@@ -91,19 +65,9 @@ func SetSelectedValues(id string, uid uint32, v *Values, handler func(device.Res
 }
 
 // SetSelectedValuesFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetSelectedValuesFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Values) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetSelectedValues("setselectedvalues"+device.GenId(), uid, v,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetSelectedValuesFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *Values) error {
+	return device.FutureEmpty(brick, connectorname, SetSelectedValues("setselectedvalues"+device.GenId(), uid, v, nil))
 }
 
 // Value is the type for the output bitmap mask (4bit).
@@ -0,0 +1,65 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package joystick
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetAnalogValue creates a subscriber to get the raw 12-bit analog values of both axes.
+// It is only useful, if you need the full resolution of the analog-to-digital converter.
+// Please use normaly GetPosition.
+func GetAnalogValue(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAnalogValue"),
+		Fid:        function_get_analog_value,
+		Uid:        uid,
+		Result:     &AnalogValue{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAnalogValueFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AnalogValue, error) {
+	return device.FutureOf[*AnalogValue](brick, connectorname, GetAnalogValue("getanalogvaluefuture"+device.GenId(), uid, nil))
+}
+
+// AnalogValue is a type for the 12-bit analog-to-digial converter values of both axes.
+// The values have a range of 0 to 4095.
+type AnalogValue struct {
+	X uint16
+	Y uint16
+}
+
+// FromPacket creates from a packet a analog value.
+func (av *AnalogValue) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(av, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(av)
+}
+
+// String fullfill the stringer interface.
+func (av *AnalogValue) String() string {
+	txt := "AnalogValue "
+	if av == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %d, Y: %d]", av.X, av.Y)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (av *AnalogValue) Copy() device.Resulter {
+	if av == nil {
+		return nil
+	}
+	return &AnalogValue{X: av.X, Y: av.Y}
+}
@@ -0,0 +1,209 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Joystick Bricklet.
+package joystick
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+const (
+	function_get_position                        = uint8(1)
+	function_is_pressed                          = uint8(2)
+	function_get_analog_value                    = uint8(3)
+	function_calibrate                           = uint8(4)
+	function_set_position_callback_period        = uint8(5)
+	function_get_position_callback_period        = uint8(6)
+	function_set_analog_value_callback_period    = uint8(7)
+	function_get_analog_value_callback_period    = uint8(8)
+	function_set_position_callback_threshold     = uint8(9)
+	function_get_position_callback_threshold     = uint8(10)
+	function_set_analog_value_callback_threshold = uint8(11)
+	function_get_analog_value_callback_threshold = uint8(12)
+	function_set_debounce_period                 = uint8(13)
+	function_get_debounce_period                 = uint8(14)
+	callback_position                            = uint8(15)
+	callback_analog_value                        = uint8(16)
+	callback_position_reached                    = uint8(17)
+	callback_analog_value_reached                = uint8(18)
+	callback_pressed                             = uint8(19)
+	callback_released                            = uint8(20)
+)
+
+// GetPosition creates a subscriber to get the position of the joystick.
+// For periodical values use the PositionPeriod callback.
+func GetPosition(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPosition"),
+		Fid:        function_get_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetPosition("getpositionfuture"+device.GenId(), uid, nil))
+}
+
+// IsPressed creates a subscriber to get the state of the button of the joystick.
+// For an notification use the Pressed and Released callbacks.
+func IsPressed(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsPressed"),
+		Fid:        function_is_pressed,
+		Uid:        uid,
+		Result:     &Button{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsPressedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsPressedFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Button, error) {
+	return device.FutureOf[*Button](brick, connectorname, IsPressed("ispressedfuture"+device.GenId(), uid, nil))
+}
+
+// IsPressedFutureSimple calls the IsPressedFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsPressedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	b, err := IsPressedFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return b.IsPressed, nil
+}
+
+// Pressed creates the subscriber for the pressed callback.
+// There are no result inside, the handler is called, if the button is pressed.
+func Pressed(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Pressed"),
+		Fid:        callback_pressed,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Released creates the subscriber for the released callback.
+// There are no result inside, the handler is called, if the button is released.
+func Released(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Released"),
+		Fid:        callback_released,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Calibrate creates a subscriber to calibrate the middle position of the joystick.
+// The joystick must be in the middle position, while the calibration is running.
+// The calibration is stored in the EEPROM and only needed once.
+func Calibrate(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Calibrate"),
+		Fid:        function_calibrate,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// CalibrateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func CalibrateFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Calibrate("calibratefuture"+device.GenId(), uid, nil))
+}
+
+// Position is the position of the joystick.
+// The values of both axes have a range of -100 to 100, the middle position is 0.
+type Position struct {
+	X int16
+	Y int16
+}
+
+// FromPacket creates from a packet a position.
+func (po *Position) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(po, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(po)
+}
+
+// String fullfill the stringer interface.
+func (po *Position) String() string {
+	txt := "Position "
+	if po == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[X: %d, Y: %d]", po.X, po.Y)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (po *Position) Copy() device.Resulter {
+	if po == nil {
+		return nil
+	}
+	return &Position{X: po.X, Y: po.Y}
+}
+
+// Button is the type for the return of the IsPressed subscriber.
+type Button struct {
+	IsPressed bool
+}
+
+// FromPacket converts the packet payload to the Button type.
+func (b *Button) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(b, p); err != nil {
+		return err
+	}
+	br := new(ButtonRaw)
+	err := p.Payload.Decode(br)
+	if err == nil {
+		b.FromButtonRaw(br)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (b *Button) String() string {
+	txt := "Button "
+	if b == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[IsPressed: %t]", b.IsPressed)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (b *Button) Copy() device.Resulter {
+	if b == nil {
+		return nil
+	}
+	return &Button{IsPressed: b.IsPressed}
+}
+
+// FromButtonRaw converts a ButtonRaw into a Button.
+func (b *Button) FromButtonRaw(br *ButtonRaw) {
+	if b == nil || br == nil {
+		return
+	}
+	b.IsPressed = misc.Uint8ToBool(br.IsPressed)
+}
+
+// ButtonRaw is the real de/encoding type for a Button.
+type ButtonRaw struct {
+	IsPressed uint8
+}
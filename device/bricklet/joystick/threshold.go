@@ -0,0 +1,216 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package joystick
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetPositionCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0, 0, 0).
+func SetPositionCallbackThreshold(id string, uid uint32, t *PositionThreshold, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPositionCallbackThreshold"),
+		Fid:        function_set_position_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPositionCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPositionCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *PositionThreshold) error {
+	return device.FutureEmpty(brick, connectorname, SetPositionCallbackThreshold("setpositioncallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetPositionCallbackThreshold creates the subscriber to get the callback thresold.
+func GetPositionCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPositionCallbackThreshold"),
+		Fid:        function_get_position_callback_threshold,
+		Uid:        uid,
+		Result:     &PositionThreshold{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPositionCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPositionCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*PositionThreshold, error) {
+	return device.FutureOf[*PositionThreshold](brick, connectorname, GetPositionCallbackThreshold("getpositioncallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetAnalogValueCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0, 0, 0).
+func SetAnalogValueCallbackThreshold(id string, uid uint32, t *AnalogValueThreshold, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAnalogValueCallbackThreshold"),
+		Fid:        function_set_analog_value_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAnalogValueCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *AnalogValueThreshold) error {
+	return device.FutureEmpty(brick, connectorname, SetAnalogValueCallbackThreshold("setanalogvaluecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetAnalogValueCallbackThreshold creates the subscriber to get the callback thresold.
+func GetAnalogValueCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAnalogValueCallbackThreshold"),
+		Fid:        function_get_analog_value_callback_threshold,
+		Uid:        uid,
+		Result:     &AnalogValueThreshold{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAnalogValueCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAnalogValueCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*AnalogValueThreshold, error) {
+	return device.FutureOf[*AnalogValueThreshold](brick, connectorname, GetAnalogValueCallbackThreshold("getanalogvaluecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// PositionReached creates a subscriber for the threshold triggered position callback.
+func PositionReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "PositionReached"),
+		Fid:        callback_position_reached,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// AnalogValueReached creates a subscriber for the threshold triggered analog value callback.
+func AnalogValueReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AnalogValueReached"),
+		Fid:        callback_analog_value_reached,
+		Uid:        uid,
+		Result:     &AnalogValue{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// PositionThreshold is the threshold of the position callback for both axes.
+// The callback is triggered, if both axes meet the threshold option.
+type PositionThreshold struct {
+	Option byte
+	MinX   int16
+	MaxX   int16
+	MinY   int16
+	MaxY   int16
+}
+
+// FromPacket convert the packet payload to the theshold type.
+func (t *PositionThreshold) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// Name convert the threshold option to a readable string.
+func (t *PositionThreshold) Name() string {
+	if t == nil {
+		return ""
+	}
+	return device.ThresholdName(t.Option)
+}
+
+// String fullfill the stringer interface.
+func (t *PositionThreshold) String() string {
+	txt := "Threshold "
+	if t == nil {
+		return txt + "[nil]"
+	}
+	txt += "[Option: " + t.Name()
+	if t.Option == device.ThresholdOutside || t.Option == device.ThresholdInside {
+		txt += fmt.Sprintf(", MinX: %d, MaxX: %d, MinY: %d, MaxY: %d", t.MinX, t.MaxX, t.MinY, t.MaxY)
+	} else if t.Option == device.ThresholdBiggerMin || t.Option == device.ThresholdSmallerMin {
+		txt += fmt.Sprintf(", MinX: %d, MinY: %d", t.MinX, t.MinY)
+	}
+	return txt + "]"
+}
+
+// Copy creates a copy of the content.
+func (t *PositionThreshold) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &PositionThreshold{
+		Option: t.Option,
+		MinX:   t.MinX,
+		MaxX:   t.MaxX,
+		MinY:   t.MinY,
+		MaxY:   t.MaxY}
+}
+
+// AnalogValueThreshold is the threshold of the analog value callback for both axes.
+// The callback is triggered, if both axes meet the threshold option.
+type AnalogValueThreshold struct {
+	Option byte
+	MinX   uint16
+	MaxX   uint16
+	MinY   uint16
+	MaxY   uint16
+}
+
+// FromPacket convert the packet payload to the theshold type.
+func (t *AnalogValueThreshold) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// Name convert the threshold option to a readable string.
+func (t *AnalogValueThreshold) Name() string {
+	if t == nil {
+		return ""
+	}
+	return device.ThresholdName(t.Option)
+}
+
+// String fullfill the stringer interface.
+func (t *AnalogValueThreshold) String() string {
+	txt := "Threshold "
+	if t == nil {
+		return txt + "[nil]"
+	}
+	txt += "[Option: " + t.Name()
+	if t.Option == device.ThresholdOutside || t.Option == device.ThresholdInside {
+		txt += fmt.Sprintf(", MinX: %d, MaxX: %d, MinY: %d, MaxY: %d", t.MinX, t.MaxX, t.MinY, t.MaxY)
+	} else if t.Option == device.ThresholdBiggerMin || t.Option == device.ThresholdSmallerMin {
+		txt += fmt.Sprintf(", MinX: %d, MinY: %d", t.MinX, t.MinY)
+	}
+	return txt + "]"
+}
+
+// Copy creates a copy of the content.
+func (t *AnalogValueThreshold) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &AnalogValueThreshold{
+		Option: t.Option,
+		MinX:   t.MinX,
+		MaxX:   t.MaxX,
+		MinY:   t.MinY,
+		MaxY:   t.MaxY}
+}
@@ -21,19 +21,10 @@ func BacklightOn(id string, uid uint32, handler func(device.Resulter, error)) *d
 		WithPacket: true}.CreateDevice()
 }
 
-func BacklightOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	future := make(chan bool)
-	sub := BacklightOn("backlightonfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	b := <-future
-	close(future)
-	return b
+// BacklightOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func BacklightOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, BacklightOn("backlightonfuture"+device.GenId(), uid, nil))
 }
 
 func BacklightOff(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
@@ -45,19 +36,10 @@ func BacklightOff(id string, uid uint32, handler func(device.Resulter, error)) *
 		WithPacket: true}.CreateDevice()
 }
 
-func BacklightOffFuture(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	future := make(chan bool)
-	sub := BacklightOff("backlightofffuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	b := <-future
-	close(future)
-	return b
+// BacklightOffFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func BacklightOffFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, BacklightOff("backlightofffuture"+device.GenId(), uid, nil))
 }
 
 func IsBacklightOn(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
@@ -70,33 +52,20 @@ func IsBacklightOn(id string, uid uint32, handler func(device.Resulter, error))
 		WithPacket: true}.CreateDevice()
 }
 
-func IsBacklightOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Backlight {
-	future := make(chan *Backlight)
-	sub := IsBacklightOn("isbacklightonfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Backlight = nil
-			if err == nil {
-				if value, ok := r.(*Backlight); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	close(future)
-	return v
+// IsBacklightOnFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsBacklightOnFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Backlight, error) {
+	return device.FutureOf[*Backlight](brick, connectorname, IsBacklightOn("isbacklightonfuture"+device.GenId(), uid, nil))
 }
 
-func IsBacklightOnFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	bl := IsBacklightOnFuture(brick, connectorname, uid)
-	if bl != nil && bl.IsOn {
-		return true
+// IsBacklightOnFutureSimple calls the IsBacklightOnFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsBacklightOnFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	bl, err := IsBacklightOnFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
 	}
-	return false
+	return bl.IsOn, nil
 }
 
 // Backlight is a type for the return of the IsBacklightOn subscriber.
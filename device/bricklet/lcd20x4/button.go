@@ -25,36 +25,19 @@ func IsButtonPressed(id string, uid uint32, button *Button, handler func(device.
 }
 
 // IsButtonPressedFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func IsButtonPressedFuture(brick *bricker.Bricker, connectorname string, uid uint32, button *Button) *Pressed {
-	future := make(chan *Pressed)
-	defer close(future)
-	sub := IsButtonPressed("isbuttonpressedfuture"+device.GenId(), uid, button,
-		func(r device.Resulter, err error) {
-			var v *Pressed = nil
-			if err == nil {
-				if value, ok := r.(*Pressed); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func IsButtonPressedFuture(brick *bricker.Bricker, connectorname string, uid uint32, button *Button) (*Pressed, error) {
+	return device.FutureOf[*Pressed](brick, connectorname, IsButtonPressed("isbuttonpressedfuture"+device.GenId(), uid, button, nil))
 }
 
 // IsButtonPressedFutureSimple calls the IsButtonPressedFuture method with a simple boolean result.
-// If it fails, the result is false.
-func IsButtonPressedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32, button *Button) bool {
-	p := IsButtonPressedFuture(brick, connectorname, uid, button)
-	if p == nil {
-		return false
+// If an error occur, the result is false and the error is returned.
+func IsButtonPressedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32, button *Button) (bool, error) {
+	p, err := IsButtonPressedFuture(brick, connectorname, uid, button)
+	if err != nil {
+		return false, err
 	}
-	return p.IsPressed
+	return p.IsPressed, nil
 }
 
 // ButtonPressed creates a subscriber for the button pressed callback.
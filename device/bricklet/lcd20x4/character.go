@@ -23,20 +23,9 @@ func SetCustomCharacter(id string, uid uint32, c *CustomCharacter, handler func(
 }
 
 // SetCustomCharacterFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetCustomCharacterFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *CustomCharacter) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetCustomCharacter("setcustomcharacterfuture"+device.GenId(), uid, c,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	b := <-future
-	return b
+// If an error occur, the error is returned.
+func SetCustomCharacterFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *CustomCharacter) error {
+	return device.FutureEmpty(brick, connectorname, SetCustomCharacter("setcustomcharacterfuture"+device.GenId(), uid, c, nil))
 }
 
 // GetCustomCharacter creates a subscriber to get a stored custom character at the given index.
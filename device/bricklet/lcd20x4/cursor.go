@@ -22,19 +22,10 @@ func SetConfig(id string, uid uint32, cursor *Cursor, handler func(device.Result
 		WithPacket: true}.CreateDevice()
 }
 
-func SetConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, cursor *Cursor) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetConfig("setconfigfuture"+device.GenId(), uid, cursor,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	b := <-future
-	return b
+// SetConfigFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, cursor *Cursor) error {
+	return device.FutureEmpty(brick, connectorname, SetConfig("setconfigfuture"+device.GenId(), uid, cursor, nil))
 }
 
 func GetConfig(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
@@ -47,25 +38,10 @@ func GetConfig(id string, uid uint32, handler func(device.Resulter, error)) *dev
 		WithPacket: true}.CreateDevice()
 }
 
-func GetConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Cursor {
-	future := make(chan *Cursor)
-	sub := GetConfig("getconfigfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Cursor = nil
-			if err == nil {
-				if value, ok := r.(*Cursor); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	close(future)
-	return v
+// GetConfigFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Cursor, error) {
+	return device.FutureOf[*Cursor](brick, connectorname, GetConfig("getconfigfuture"+device.GenId(), uid, nil))
 }
 
 // Cursor config type. For setting or getting the cursor state.
@@ -25,20 +25,9 @@ func SetDefaultText(id string, uid uint32, dtl *DefaultTextLine, handler func(r
 }
 
 // SetDefaultTextFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetDefaultTextFuture(brick *bricker.Bricker, connectorname string, uid uint32, dtl *DefaultTextLine) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetDefaultText("setdefaulttextfuture"+device.GenId(), uid, dtl,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	v := <-future
-	return v
+// If an error occur, the error is returned.
+func SetDefaultTextFuture(brick *bricker.Bricker, connectorname string, uid uint32, dtl *DefaultTextLine) error {
+	return device.FutureEmpty(brick, connectorname, SetDefaultText("setdefaulttextfuture"+device.GenId(), uid, dtl, nil))
 }
 
 // GetDefaultText creates a new subscriber to get the default text on the given line.
@@ -54,26 +43,9 @@ func GetDefaultText(id string, uid uint32, l *Line, handler func(r device.Result
 }
 
 // GetDefaultTextFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetDefaultTextFuture(brick *bricker.Bricker, connectorname string, uid uint32, l *Line) *Text {
-	future := make(chan *Text)
-	defer close(future)
-	sub := GetDefaultText("getdefaulttextfuture"+device.GenId(), uid, l,
-		func(r device.Resulter, err error) {
-			var v *Text = nil
-			if err != nil {
-				if value, ok := r.(*Text); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetDefaultTextFuture(brick *bricker.Bricker, connectorname string, uid uint32, l *Line) (*Text, error) {
+	return device.FutureOf[*Text](brick, connectorname, GetDefaultText("getdefaulttextfuture"+device.GenId(), uid, l, nil))
 }
 
 // SetDefaultTextCounter creates a subscribte to set the default text output counter (timeout).
@@ -91,20 +63,9 @@ func SetDefaultTextCounter(id string, uid uint32, c *Counter, handler func(r dev
 }
 
 // SetDefaultTextCounterFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetDefaultTextCounterFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Counter) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetDefaultTextCounter("setdefaulttextcounterfuture"+device.GenId(), uid, c,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	v := <-future
-	return v
+// If an error occur, the error is returned.
+func SetDefaultTextCounterFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Counter) error {
+	return device.FutureEmpty(brick, connectorname, SetDefaultTextCounter("setdefaulttextcounterfuture"+device.GenId(), uid, c, nil))
 }
 
 // GetDefaultTextCounter creates a subscriber to get the value from the counter.
@@ -120,26 +81,9 @@ func GetDefaultTextCounter(id string, uid uint32, handler func(r device.Resulter
 }
 
 // GetDefaultTextCounterFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetDefaultTextCounterFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Counter {
-	future := make(chan *Counter)
-	defer close(future)
-	sub := GetDefaultTextCounter("getdefaulttextcounterfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Counter = nil
-			if err != nil {
-				if value, ok := r.(*Counter); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetDefaultTextCounterFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Counter, error) {
+	return device.FutureOf[*Counter](brick, connectorname, GetDefaultTextCounter("getdefaulttextcounterfuture"+device.GenId(), uid, nil))
 }
 
 // DefaultTextLine is the type for a full text line to display.
@@ -20,17 +20,7 @@ func ClearDisplay(id string, uid uint32, handler func(device.Resulter, error)) *
 }
 
 // ClearDisplayFuture is the future version of the ClearDisplay subscriber.
-func ClearDisplayFuture(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	future := make(chan bool)
-	sub := ClearDisplay("cleardisplayfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	b := <-future
-	close(future)
-	return b
+// If an error occur, the error is returned.
+func ClearDisplayFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, ClearDisplay("cleardisplayfuture"+device.GenId(), uid, nil))
 }
@@ -23,19 +23,9 @@ func WriteLine(id string, uid uint32, ltl *LcdTextLine, handler func(r device.Re
 }
 
 // WriteLineFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func WriteLineFuture(brick *bricker.Bricker, connectorname string, uid uint32, ltl *LcdTextLine) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := WriteLine("writelinefuture"+device.GenId(), uid, ltl, func(r device.Resulter, err error) {
-		future <- device.IsEmptyResultOk(r, err)
-	})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	v := <-future
-	return v
+// If an error occur, the error is returned.
+func WriteLineFuture(brick *bricker.Bricker, connectorname string, uid uint32, ltl *LcdTextLine) error {
+	return device.FutureEmpty(brick, connectorname, WriteLine("writelinefuture"+device.GenId(), uid, ltl, nil))
 }
 
 // LcdTextLine is the type for a text line to display.
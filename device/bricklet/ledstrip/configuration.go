@@ -0,0 +1,264 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ledstrip
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetChipType creates the subscriber to set the type of the LED driver chip.
+// The default value is WS2801 (ChipTypeWS2801).
+func SetChipType(id string, uid uint32, c *ChipType, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetChipType"),
+		Fid:        function_set_chip_type,
+		Uid:        uid,
+		Data:       c,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetChipTypeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetChipTypeFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *ChipType) error {
+	return device.FutureEmpty(brick, connectorname, SetChipType("setchiptypefuture"+device.GenId(), uid, c, nil))
+}
+
+// GetChipType creates the subscriber to get the type of the LED driver chip.
+func GetChipType(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetChipType"),
+		Fid:        function_get_chip_type,
+		Uid:        uid,
+		Result:     &ChipType{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetChipTypeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetChipTypeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ChipType, error) {
+	return device.FutureOf[*ChipType](brick, connectorname, GetChipType("getchiptypefuture"+device.GenId(), uid, nil))
+}
+
+// SetClockFrequency creates the subscriber to set the clock frequency (Hz) of the data transfer to the LEDs.
+// The frequency could be set between 10kHz and 2MHz, the default value is 1.666666MHz.
+// Not all chips use a clock line (e.g. WS2811 and WS2812).
+func SetClockFrequency(id string, uid uint32, f *ClockFrequency, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetClockFrequency"),
+		Fid:        function_set_clock_frequency,
+		Uid:        uid,
+		Data:       f,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetClockFrequencyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetClockFrequencyFuture(brick *bricker.Bricker, connectorname string, uid uint32, f *ClockFrequency) error {
+	return device.FutureEmpty(brick, connectorname, SetClockFrequency("setclockfrequencyfuture"+device.GenId(), uid, f, nil))
+}
+
+// GetClockFrequency creates the subscriber to get the used clock frequency (Hz).
+// The used frequency could differ from the frequency set with SetClockFrequency.
+func GetClockFrequency(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetClockFrequency"),
+		Fid:        function_get_clock_frequency,
+		Uid:        uid,
+		Result:     &ClockFrequency{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetClockFrequencyFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetClockFrequencyFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ClockFrequency, error) {
+	return device.FutureOf[*ClockFrequency](brick, connectorname, GetClockFrequency("getclockfrequencyfuture"+device.GenId(), uid, nil))
+}
+
+// SetChannelMapping creates the subscriber to set the order of the color channels on the strip.
+// The default value is BGR (ChannelMappingBGR).
+func SetChannelMapping(id string, uid uint32, m *ChannelMapping, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetChannelMapping"),
+		Fid:        function_set_channel_mapping,
+		Uid:        uid,
+		Data:       m,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetChannelMappingFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetChannelMappingFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *ChannelMapping) error {
+	return device.FutureEmpty(brick, connectorname, SetChannelMapping("setchannelmappingfuture"+device.GenId(), uid, m, nil))
+}
+
+// GetChannelMapping creates the subscriber to get the channel mapping.
+func GetChannelMapping(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetChannelMapping"),
+		Fid:        function_get_channel_mapping,
+		Uid:        uid,
+		Result:     &ChannelMapping{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetChannelMappingFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetChannelMappingFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ChannelMapping, error) {
+	return device.FutureOf[*ChannelMapping](brick, connectorname, GetChannelMapping("getchannelmappingfuture"+device.GenId(), uid, nil))
+}
+
+// GetSupplyVoltage creates the subscriber to get the supply voltage of the LEDs (mV).
+func GetSupplyVoltage(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSupplyVoltage"),
+		Fid:        function_get_supply_voltage,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSupplyVoltageFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSupplyVoltageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Voltage, error) {
+	return device.FutureOf[*Voltage](brick, connectorname, GetSupplyVoltage("getsupplyvoltagefuture"+device.GenId(), uid, nil))
+}
+
+// ChipType is the type of the LED driver chip.
+type ChipType struct {
+	Value uint16
+}
+
+// FromPacket creates from a packet a ChipType.
+func (c *ChipType) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *ChipType) String() string {
+	txt := "Chip Type "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", ChipTypeName(c.Value), c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *ChipType) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &ChipType{Value: c.Value}
+}
+
+// ClockFrequency is the clock frequency of the data transfer in Hz.
+type ClockFrequency struct {
+	Value uint32 // Hz
+}
+
+// FromPacket creates from a packet a ClockFrequency.
+func (f *ClockFrequency) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(f, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(f)
+}
+
+// String fullfill the stringer interface.
+func (f *ClockFrequency) String() string {
+	txt := "Clock Frequency "
+	if f == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d Hz]", f.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (f *ClockFrequency) Copy() device.Resulter {
+	if f == nil {
+		return nil
+	}
+	return &ClockFrequency{Value: f.Value}
+}
+
+// ChannelMapping is the order of the color channels on the strip.
+type ChannelMapping struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a ChannelMapping.
+func (m *ChannelMapping) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(m, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(m)
+}
+
+// String fullfill the stringer interface.
+func (m *ChannelMapping) String() string {
+	txt := "Channel Mapping "
+	if m == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", ChannelMappingName(m.Value), m.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (m *ChannelMapping) Copy() device.Resulter {
+	if m == nil {
+		return nil
+	}
+	return &ChannelMapping{Value: m.Value}
+}
+
+// Voltage is the supply voltage of the LEDs in mV.
+type Voltage struct {
+	Value uint16 // mV
+}
+
+// FromPacket creates from a packet a Voltage.
+func (v *Voltage) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(v, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(v)
+}
+
+// String fullfill the stringer interface.
+func (v *Voltage) String() string {
+	txt := "Voltage "
+	if v == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mV]", v.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (v *Voltage) Copy() device.Resulter {
+	if v == nil {
+		return nil
+	}
+	return &Voltage{Value: v.Value}
+}
@@ -0,0 +1,226 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ledstrip
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetFrameDuration creates the subscriber to set the frame duration (ms).
+// After every frame the FrameRendered callback is triggered.
+// The default value is 100ms (10 frames per second).
+func SetFrameDuration(id string, uid uint32, d *FrameDuration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetFrameDuration"),
+		Fid:        function_set_frame_duration,
+		Uid:        uid,
+		Data:       d,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetFrameDurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetFrameDurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *FrameDuration) error {
+	return device.FutureEmpty(brick, connectorname, SetFrameDuration("setframedurationfuture"+device.GenId(), uid, d, nil))
+}
+
+// GetFrameDuration creates the subscriber to get the frame duration.
+func GetFrameDuration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetFrameDuration"),
+		Fid:        function_get_frame_duration,
+		Uid:        uid,
+		Result:     &FrameDuration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetFrameDurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetFrameDurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*FrameDuration, error) {
+	return device.FutureOf[*FrameDuration](brick, connectorname, GetFrameDuration("getframedurationfuture"+device.GenId(), uid, nil))
+}
+
+// FrameRendered creates a subscriber for the frame rendered callback.
+// The callback is triggered directly after a new frame is rendered,
+// this is the right moment to send the data for the next frame.
+func FrameRendered(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "FrameRendered"),
+		Fid:        callback_frame_rendered,
+		Uid:        uid,
+		Result:     &Rendered{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// EnableFrameRenderedCallback creates the subscriber to enable the frame rendered callback.
+func EnableFrameRenderedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "EnableFrameRenderedCallback"),
+		Fid:        function_enable_frame_rendered_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// EnableFrameRenderedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func EnableFrameRenderedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, EnableFrameRenderedCallback("enableframerenderedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// DisableFrameRenderedCallback creates the subscriber to disable the frame rendered callback.
+func DisableFrameRenderedCallback(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DisableFrameRenderedCallback"),
+		Fid:        function_disable_frame_rendered_callback,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DisableFrameRenderedCallbackFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DisableFrameRenderedCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DisableFrameRenderedCallback("disableframerenderedcallbackfuture"+device.GenId(), uid, nil))
+}
+
+// IsFrameRenderedCallbackEnabled creates the subscriber to get the state of the frame rendered callback.
+func IsFrameRenderedCallbackEnabled(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsFrameRenderedCallbackEnabled"),
+		Fid:        function_is_frame_rendered_callback_enabled,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsFrameRenderedCallbackEnabledFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsFrameRenderedCallbackEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsFrameRenderedCallbackEnabled("isframerenderedcallbackenabledfuture"+device.GenId(), uid, nil))
+}
+
+// FrameDuration is the duration of a frame in ms.
+type FrameDuration struct {
+	Value uint16 // ms
+}
+
+// FromPacket creates from a packet a FrameDuration.
+func (f *FrameDuration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(f, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(f)
+}
+
+// String fullfill the stringer interface.
+func (f *FrameDuration) String() string {
+	txt := "Frame Duration "
+	if f == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d ms]", f.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (f *FrameDuration) Copy() device.Resulter {
+	if f == nil {
+		return nil
+	}
+	return &FrameDuration{Value: f.Value}
+}
+
+// Rendered is the result of the frame rendered callback with the number of LEDs of the rendered frame.
+type Rendered struct {
+	Length uint16
+}
+
+// FromPacket creates from a packet a Rendered.
+func (r *Rendered) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(r, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(r)
+}
+
+// String fullfill the stringer interface.
+func (r *Rendered) String() string {
+	txt := "Rendered "
+	if r == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Length: %d]", r.Length)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (r *Rendered) Copy() device.Resulter {
+	if r == nil {
+		return nil
+	}
+	return &Rendered{Length: r.Length}
+}
+
+// Enabled is a type for showing if a callback is enabled or disabled.
+type Enabled struct {
+	Value bool // true - enabled, false - disabled
+}
+
+// FromPacket converts the packet payload to the Enabled type.
+func (e *Enabled) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(EnabledRaw)
+	err := p.Payload.Decode(er)
+	if err == nil && er != nil {
+		e.FromEnabledRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (e *Enabled) String() string {
+	txt := "Enabled "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", e.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Enabled) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Enabled{Value: e.Value}
+}
+
+// FromEnabledRaw converts the EnabledRaw into a Enabled.
+func (e *Enabled) FromEnabledRaw(er *EnabledRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Value = misc.Uint8ToBool(er.Value)
+}
+
+// EnabledRaw is the real de/encoding type for a Enabled.
+type EnabledRaw struct {
+	Value uint8
+}
@@ -0,0 +1,238 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ledstrip
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"sync"
+	"sync/atomic"
+)
+
+// Color is the color of a single LED.
+type Color struct {
+	R uint8
+	G uint8
+	B uint8
+}
+
+// String fullfill the stringer interface.
+func (c Color) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+/*
+FrameBuffer holds the colors of all LEDs of a strip with arbitrary length.
+
+The LED Strip Bricklet could only set 16 LEDs with one call,
+the frame buffer splits the whole strip into the needed calls.
+
+	fb := ledstrip.NewFrameBuffer(50)
+	fb.Fill(ledstrip.Color{R: 255})
+	err := fb.Write(brick, "local", uid)
+*/
+type FrameBuffer struct {
+	Leds []Color
+}
+
+// NewFrameBuffer creates a frame buffer for a strip with the given number of LEDs (all LEDs are off).
+func NewFrameBuffer(length int) *FrameBuffer {
+	if length < 0 {
+		length = 0
+	}
+	return &FrameBuffer{Leds: make([]Color, length)}
+}
+
+// Len gives the number of LEDs of the frame buffer.
+func (f *FrameBuffer) Len() int {
+	return len(f.Leds)
+}
+
+// Set sets the color of the LED i, a LED outside the strip is ignored.
+func (f *FrameBuffer) Set(i int, c Color) {
+	if i >= 0 && i < len(f.Leds) {
+		f.Leds[i] = c
+	}
+}
+
+// Get gives the color of the LED i, a LED outside the strip is off.
+func (f *FrameBuffer) Get(i int) Color {
+	if i >= 0 && i < len(f.Leds) {
+		return f.Leds[i]
+	}
+	return Color{}
+}
+
+// Fill sets all LEDs to the color.
+func (f *FrameBuffer) Fill(c Color) {
+	for i := range f.Leds {
+		f.Leds[i] = c
+	}
+}
+
+// Clear switches all LEDs off.
+func (f *FrameBuffer) Clear() {
+	f.Fill(Color{})
+}
+
+// Packets splits the frame buffer into the values for the SetRGBValues calls (16 LEDs per call).
+func (f *FrameBuffer) Packets() []*RGBValues {
+	packets := make([]*RGBValues, 0, (len(f.Leds)+LedsPerCall-1)/LedsPerCall)
+	for index := 0; index < len(f.Leds); index += LedsPerCall {
+		v := &RGBValues{Index: uint16(index)}
+		for i := 0; i < LedsPerCall && index+i < len(f.Leds); i++ {
+			c := f.Leds[index+i]
+			v.R[i], v.G[i], v.B[i] = c.R, c.G, c.B
+			v.Length++
+		}
+		packets = append(packets, v)
+	}
+	return packets
+}
+
+// Write sends the whole frame buffer to the LED Strip Bricklet.
+// The frame buffer is shown with the next rendered frame.
+// If an error occur, the sending stops and the error is returned.
+func (f *FrameBuffer) Write(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	for _, v := range f.Packets() {
+		if err := SetRGBValuesFuture(brick, connectorname, uid, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Animation renders frames of a strip synchronized with the frame rendered callback.
+
+Every time, the bricklet has rendered a frame, the render function is called with
+the number of the next frame and the frame buffer, which contains the last frame.
+The changed frame buffer is written directly to the bricklet and shown with the next frame.
+If the render function results false, the animation stops.
+
+	a := ledstrip.NewAnimation(brick, "local", uid, 50, func(n uint64, fb *ledstrip.FrameBuffer) bool {
+		fb.Clear()
+		fb.Set(int(n%uint64(fb.Len())), ledstrip.Color{G: 255})
+		return true
+	})
+	err := a.Start(&ledstrip.FrameDuration{Value: 50})
+	...
+	a.Stop()
+*/
+type Animation struct {
+	brick         *bricker.Bricker
+	connectorname string
+	uid           uint32
+	frame         *FrameBuffer
+	render        func(uint64, *FrameBuffer) bool
+	lock          sync.Mutex
+	busy          atomic.Bool // a frame is rendered and written
+	n             uint64
+	sub           *device.Device
+	err           error
+	done          chan struct{}
+}
+
+// NewAnimation creates a animation for a strip with the given number of LEDs.
+func NewAnimation(brick *bricker.Bricker, connectorname string, uid uint32, length int,
+	render func(uint64, *FrameBuffer) bool) *Animation {
+	return &Animation{
+		brick:         brick,
+		connectorname: connectorname,
+		uid:           uid,
+		frame:         NewFrameBuffer(length),
+		render:        render}
+}
+
+// Start sets the frame duration, subscribes the frame rendered callback and writes the first frame.
+// A running animation is not started again.
+func (a *Animation) Start(d *FrameDuration) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.sub != nil {
+		return nil
+	}
+	if err := SetFrameDurationFuture(a.brick, a.connectorname, a.uid, d); err != nil {
+		return err
+	}
+	a.err = nil
+	a.done = make(chan struct{})
+	a.sub = FrameRendered("animation"+device.GenId(), a.uid, func(r device.Resulter, err error) {
+		if err == nil && a.busy.CompareAndSwap(false, true) {
+			go a.next()
+		}
+	})
+	if err := a.brick.Subscribe(a.sub, a.connectorname); err != nil {
+		a.sub = nil
+		close(a.done)
+		return err
+	}
+	if !a.step() {
+		return a.err
+	}
+	return nil
+}
+
+// Stop stops the animation, the last frame remains on the strip.
+func (a *Animation) Stop() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.stop()
+}
+
+// Done gives a channel, which is closed after the animation has stopped.
+// Before the first start the result is nil.
+func (a *Animation) Done() <-chan struct{} {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.done
+}
+
+// Err gives the error, which has stopped the animation.
+// The result is nil, if the animation runs or was stopped without an error.
+func (a *Animation) Err() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.err
+}
+
+// Internal method: next renders and writes the next frame, if the animation runs.
+// The frame rendered callbacks, which come while the frame is written, are skipped,
+// so a write slower than the frame duration lets the animation skip frames instead of queuing them up.
+func (a *Animation) next() {
+	defer a.busy.Store(false)
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.sub != nil {
+		a.step()
+	}
+}
+
+// Internal method: step renders and writes a frame, stops the animation at the end or on an error.
+// The lock must be held.
+func (a *Animation) step() bool {
+	if !a.render(a.n, a.frame) {
+		a.stop()
+		return false
+	}
+	a.n++
+	if err := a.frame.Write(a.brick, a.connectorname, a.uid); err != nil {
+		a.err = err
+		a.stop()
+		return false
+	}
+	return true
+}
+
+// Internal method: stop unsubscribes the callback, the lock must be held.
+func (a *Animation) stop() {
+	if a.sub == nil {
+		return
+	}
+	a.brick.Unsubscribe(a.sub)
+	a.sub = nil
+	close(a.done)
+}
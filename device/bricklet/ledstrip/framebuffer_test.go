@@ -0,0 +1,213 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ledstrip
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"sync"
+	"testing"
+	"time"
+)
+
+const uid = uint32(4711)
+
+func TestPackets(t *testing.T) {
+	tests := []struct {
+		length  int
+		lengths []uint8
+	}{{length: 0, lengths: []uint8{}},
+		{length: 1, lengths: []uint8{1}},
+		{length: 16, lengths: []uint8{16}},
+		{length: 17, lengths: []uint8{16, 1}},
+		{length: 50, lengths: []uint8{16, 16, 16, 2}}}
+	for _, ts := range tests {
+		fb := NewFrameBuffer(ts.length)
+		for i := 0; i < fb.Len(); i++ {
+			fb.Set(i, Color{R: uint8(i), G: uint8(i + 1), B: uint8(i + 2)})
+		}
+		ps := fb.Packets()
+		if len(ps) != len(ts.lengths) {
+			t.Fatalf("Error TestPackets: wrong number of packets for %d LEDs (%d != %d).", ts.length, len(ps), len(ts.lengths))
+		}
+		for n, p := range ps {
+			if p.Index != uint16(n*LedsPerCall) || p.Length != ts.lengths[n] {
+				t.Fatalf("Error TestPackets: wrong packet %d for %d LEDs (index %d, length %d).", n, ts.length, p.Index, p.Length)
+			}
+			for i := 0; i < int(p.Length); i++ {
+				if c := (Color{R: p.R[i], G: p.G[i], B: p.B[i]}); c != fb.Get(int(p.Index)+i) {
+					t.Fatalf("Error TestPackets: wrong color of LED %d (%s != %s).", int(p.Index)+i, c, fb.Get(int(p.Index)+i))
+				}
+			}
+			for i := int(p.Length); i < LedsPerCall; i++ {
+				if p.R[i] != 0 || p.G[i] != 0 || p.B[i] != 0 {
+					t.Fatalf("Error TestPackets: unused LED %d of packet %d is not off.", i, n)
+				}
+			}
+		}
+	}
+}
+
+func TestFrameBuffer(t *testing.T) {
+	fb := NewFrameBuffer(3)
+	fb.Fill(Color{R: 1, G: 2, B: 3})
+	fb.Set(5, Color{R: 9})
+	fb.Set(-1, Color{R: 9})
+	if fb.Len() != 3 || fb.Get(2) != (Color{R: 1, G: 2, B: 3}) || fb.Get(5) != (Color{}) {
+		t.Fatalf("Error TestFrameBuffer: wrong frame buffer (%v).", fb.Leds)
+	}
+	fb.Clear()
+	if fb.Get(0) != (Color{}) {
+		t.Fatalf("Error TestFrameBuffer: LED not cleared (%s).", fb.Get(0))
+	}
+	if NewFrameBuffer(-1).Len() != 0 {
+		t.Fatal("Error TestFrameBuffer: negative length.")
+	}
+}
+
+// fake is a virtual LED strip bricklet, it stores all set RGB values.
+// With a hold channel, every set of RGB values waits until the channel is closed (a slow write)
+// and reports the waiting on the writing channel.
+type fake struct {
+	lock    sync.Mutex
+	values  []*RGBValues
+	hold    chan struct{}
+	writing chan struct{}
+}
+
+// generate answers the requests like the LED strip bricklet.
+func (f *fake) generate(e *event.Event) *event.Event {
+	fid := e.Packet.Head.FunctionID
+	if fid == function_set_rgb_values {
+		f.lock.Lock()
+		hold, writing := f.hold, f.writing
+		f.lock.Unlock()
+		if hold != nil {
+			select {
+			case writing <- struct{}{}:
+			default:
+			}
+			<-hold
+		}
+		v := new(RGBValues)
+		if err := e.Packet.Payload.Decode(v); err == nil {
+			f.lock.Lock()
+			f.values = append(f.values, v)
+			f.lock.Unlock()
+		}
+	}
+	return event.NewPacket(packet.NewSimpleHeaderOnly(uid, fid, true))
+}
+
+// count gives the number of set RGB values.
+func (f *fake) count() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return len(f.values)
+}
+
+func TestAnimation(t *testing.T) {
+	b := bricker.New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	f := &fake{}
+	v.AttachFallbackGenerator(f.generate)
+	if err := b.Attach(v, "virtual"); err != nil {
+		t.Fatalf("Error TestAnimation: attach failed (%s).", err)
+	}
+	frames := make([]uint64, 0)
+	a := NewAnimation(b, "virtual", uid, 20, func(n uint64, fb *FrameBuffer) bool {
+		frames = append(frames, n)
+		fb.Clear()
+		fb.Set(int(n), Color{G: 255})
+		return n < 2
+	})
+	if err := a.Start(&FrameDuration{Value: 10}); err != nil {
+		t.Fatalf("Error TestAnimation: start failed (%s).", err)
+	}
+	if c := f.count(); c != 2 {
+		t.Fatalf("Error TestAnimation: first frame needs 2 calls (%d).", c)
+	}
+	for i := 0; i < 5; i++ {
+		select {
+		case <-a.Done():
+		case <-time.After(100 * time.Millisecond):
+			v.Emit(event.NewPacket(packet.NewSimpleHeaderPayload(uid, callback_frame_rendered, false, &Rendered{Length: 20})))
+		}
+	}
+	select {
+	case <-a.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestAnimation: animation not stopped.")
+	}
+	if len(frames) != 3 || frames[2] != 2 {
+		t.Fatalf("Error TestAnimation: wrong frames rendered (%v).", frames)
+	}
+	if c := f.count(); c != 4 {
+		t.Fatalf("Error TestAnimation: wrong number of calls (%d).", c)
+	}
+	if a.Err() != nil {
+		t.Fatalf("Error TestAnimation: unexpected error (%s).", a.Err())
+	}
+}
+
+func TestAnimationSkipsFrames(t *testing.T) {
+	b := bricker.New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	f := &fake{}
+	v.AttachFallbackGenerator(f.generate)
+	if err := b.Attach(v, "virtual"); err != nil {
+		t.Fatalf("Error TestAnimationSkipsFrames: attach failed (%s).", err)
+	}
+	var lock sync.Mutex
+	frames := 0
+	a := NewAnimation(b, "virtual", uid, 20, func(n uint64, fb *FrameBuffer) bool {
+		lock.Lock()
+		frames++
+		lock.Unlock()
+		fb.Fill(Color{R: uint8(n)})
+		return true
+	})
+	if err := a.Start(&FrameDuration{Value: 10}); err != nil {
+		t.Fatalf("Error TestAnimationSkipsFrames: start failed (%s).", err)
+	}
+	defer a.Stop()
+	hold := make(chan struct{})
+	f.lock.Lock()
+	f.hold, f.writing = hold, make(chan struct{}, 1)
+	writing := f.writing
+	f.lock.Unlock()
+	rendered := func() {
+		v.Emit(event.NewPacket(packet.NewSimpleHeaderPayload(uid, callback_frame_rendered, false, &Rendered{Length: 20})))
+	}
+	rendered()
+	select {
+	case <-writing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestAnimationSkipsFrames: frame not written.")
+	}
+	for i := 0; i < 5; i++ { // callbacks while the frame is written
+		rendered()
+	}
+	time.Sleep(100 * time.Millisecond)
+	f.lock.Lock()
+	f.hold = nil
+	f.lock.Unlock()
+	close(hold)
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	if frames != 2 {
+		t.Fatalf("Error TestAnimationSkipsFrames: only one extra frame should be rendered (%d).", frames)
+	}
+	if c := f.count(); c != 4 {
+		t.Fatalf("Error TestAnimationSkipsFrames: wrong number of calls (%d).", c)
+	}
+}
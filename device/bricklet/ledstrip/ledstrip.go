@@ -0,0 +1,77 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the LED Strip Bricklet.
+package ledstrip
+
+const (
+	function_set_rgb_values                     = uint8(1)
+	function_get_rgb_values                     = uint8(2)
+	function_set_frame_duration                 = uint8(3)
+	function_get_frame_duration                 = uint8(4)
+	function_get_supply_voltage                 = uint8(5)
+	function_set_clock_frequency                = uint8(7)
+	function_get_clock_frequency                = uint8(8)
+	function_set_chip_type                      = uint8(9)
+	function_get_chip_type                      = uint8(10)
+	function_set_channel_mapping                = uint8(11)
+	function_get_channel_mapping                = uint8(12)
+	function_enable_frame_rendered_callback     = uint8(13)
+	function_disable_frame_rendered_callback    = uint8(14)
+	function_is_frame_rendered_callback_enabled = uint8(15)
+	callback_frame_rendered                     = uint8(6)
+	// LEDs
+	LedsPerCall = 16 // maximum number of LEDs, which could be set or get with one call
+	// Chip types
+	ChipTypeWS2801  = uint16(2801) // default
+	ChipTypeWS2811  = uint16(2811)
+	ChipTypeWS2812  = uint16(2812)
+	ChipTypeLPD8806 = uint16(8806)
+	ChipTypeAPA102  = uint16(102)
+	// Channel mappings
+	ChannelMappingRGB = uint8(6)
+	ChannelMappingRBG = uint8(9)
+	ChannelMappingBRG = uint8(33)
+	ChannelMappingBGR = uint8(36) // default
+	ChannelMappingGRB = uint8(18)
+	ChannelMappingGBR = uint8(24)
+)
+
+// ChipTypeName results a string representation of the given chip type.
+func ChipTypeName(c uint16) string {
+	switch c {
+	case ChipTypeWS2801:
+		return "WS2801"
+	case ChipTypeWS2811:
+		return "WS2811"
+	case ChipTypeWS2812:
+		return "WS2812"
+	case ChipTypeLPD8806:
+		return "LPD8806"
+	case ChipTypeAPA102:
+		return "APA102"
+	default:
+		return "Unknown"
+	}
+}
+
+// ChannelMappingName results a string representation of the given channel mapping.
+func ChannelMappingName(m uint8) string {
+	switch m {
+	case ChannelMappingRGB:
+		return "RGB"
+	case ChannelMappingRBG:
+		return "RBG"
+	case ChannelMappingBRG:
+		return "BRG"
+	case ChannelMappingBGR:
+		return "BGR"
+	case ChannelMappingGRB:
+		return "GRB"
+	case ChannelMappingGBR:
+		return "GBR"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ledstrip
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetRGBValues creates the subscriber to set the colors of up to 16 LEDs.
+// The LEDs from index up to index+length-1 are set with the colors of r, g and b.
+// The colors are shown with the next rendered frame (see SetFrameDuration).
+//
+// For strips with more than 16 LEDs use a FrameBuffer.
+func SetRGBValues(id string, uid uint32, v *RGBValues, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetRGBValues"),
+		Fid:        function_set_rgb_values,
+		Uid:        uid,
+		Data:       v,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetRGBValuesFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetRGBValuesFuture(brick *bricker.Bricker, connectorname string, uid uint32, v *RGBValues) error {
+	return device.FutureEmpty(brick, connectorname, SetRGBValues("setrgbvaluesfuture"+device.GenId(), uid, v, nil))
+}
+
+// GetRGBValues creates the subscriber to get the colors of up to 16 LEDs.
+// The colors of the LEDs from index up to index+length-1 are returned.
+func GetRGBValues(id string, uid uint32, r *LedRange, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetRGBValues"),
+		Fid:        function_get_rgb_values,
+		Uid:        uid,
+		Data:       r,
+		Result:     &Colors{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetRGBValuesFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetRGBValuesFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *LedRange) (*Colors, error) {
+	return device.FutureOf[*Colors](brick, connectorname, GetRGBValues("getrgbvaluesfuture"+device.GenId(), uid, r, nil))
+}
+
+// RGBValues is the type to set the colors of up to 16 LEDs beginning with the LED at the index.
+type RGBValues struct {
+	Index  uint16
+	Length uint8 // 1 - 16
+	R      [LedsPerCall]uint8
+	G      [LedsPerCall]uint8
+	B      [LedsPerCall]uint8
+}
+
+// LedRange selects the LEDs from index up to index+length-1.
+type LedRange struct {
+	Index  uint16
+	Length uint8 // 1 - 16
+}
+
+// Colors are the colors of up to 16 LEDs.
+type Colors struct {
+	R [LedsPerCall]uint8
+	G [LedsPerCall]uint8
+	B [LedsPerCall]uint8
+}
+
+// FromPacket creates from a packet a Colors.
+func (c *Colors) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Colors) String() string {
+	txt := "Colors "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[R: %v, G: %v, B: %v]", c.R, c.G, c.B)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Colors) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Colors{R: c.R, G: c.G, B: c.B}
+}
+
+// Color gives the color of the LED i (0-15) of the result.
+func (c *Colors) Color(i int) Color {
+	if i < 0 || i >= LedsPerCall {
+		return Color{}
+	}
+	return Color{R: c.R[i], G: c.G[i], B: c.B[i]}
+}
@@ -0,0 +1,88 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Linear Poti Bricklet.
+package linearpoti
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_position                        = uint8(1)
+	function_get_analog_value                    = uint8(2)
+	function_set_position_callback_period        = uint8(3)
+	function_get_position_callback_period        = uint8(4)
+	function_set_analog_value_callback_period    = uint8(5)
+	function_get_analog_value_callback_period    = uint8(6)
+	function_set_position_callback_threshold     = uint8(7)
+	function_get_position_callback_threshold     = uint8(8)
+	function_set_analog_value_callback_threshold = uint8(9)
+	function_get_analog_value_callback_threshold = uint8(10)
+	function_set_debounce_period                 = uint8(11)
+	function_get_debounce_period                 = uint8(12)
+	callback_position                            = uint8(13)
+	callback_analog_value                        = uint8(14)
+	callback_position_reached                    = uint8(15)
+	callback_analog_value_reached                = uint8(16)
+)
+
+// GetPosition creates a subscriber to get the position of the poti.
+// For periodical values use the PositionPeriod callback.
+func GetPosition(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPosition"),
+		Fid:        function_get_position,
+		Uid:        uid,
+		Result:     &Position{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPositionFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPositionFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Position, error) {
+	return device.FutureOf[*Position](brick, connectorname, GetPosition("getpositionfuture"+device.GenId(), uid, nil))
+}
+
+// Position is the position of the linear poti.
+// The value has a range of 0 (slider down) to 100 (slider up).
+type Position struct {
+	Value uint16
+}
+
+// FromPacket creates from a packet a position.
+func (po *Position) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(po, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(po)
+}
+
+// String fullfill the stringer interface.
+func (po *Position) String() string {
+	txt := "Position "
+	if po == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", po.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (po *Position) Copy() device.Resulter {
+	if po == nil {
+		return nil
+	}
+	return &Position{Value: po.Value}
+}
+
+// Percent converts the position into a percentage of the slider (0.0 to 1.0).
+func (po *Position) Percent() float64 {
+	return float64(po.Value) / 100.0
+}
@@ -38,25 +38,9 @@ func GetMoistureValue(id string, uid uint32, handler func(device.Resulter, error
 }
 
 // GetMoistureValueFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetMoistureValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Moisture {
-	future := make(chan *Moisture)
-	defer close(future)
-	sub := GetMoistureValue("getmoisturevaluefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Moisture = nil
-			if err == nil {
-				if value, ok := r.(*Moisture); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetMoistureValueFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Moisture, error) {
+	return device.FutureOf[*Moisture](brick, connectorname, GetMoistureValue("getmoisturevaluefuture"+device.GenId(), uid, nil))
 }
 
 // Moisture is the type of the moisture value.
@@ -26,19 +26,9 @@ func SetMovingAverage(id string, uid uint32, a *Average, handler func(device.Res
 }
 
 // SetMovingAverageFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *Average) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetMovingAverage("setmovingaveragefuture"+device.GenId(), uid, a,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32, a *Average) error {
+	return device.FutureEmpty(brick, connectorname, SetMovingAverage("setmovingaveragefuture"+device.GenId(), uid, a, nil))
 }
 
 // GetMovingAverage creates a subscriber to get the length of the moving average.
@@ -53,25 +43,9 @@ func GetMovingAverage(id string, uid uint32, handler func(device.Resulter, error
 }
 
 // GetMovingAverageFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Average {
-	future := make(chan *Average)
-	defer close(future)
-	sub := GetMovingAverage("getmovingaveragefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Average = nil
-			if err == nil {
-				if value, ok := r.(*Average); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetMovingAverageFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Average, error) {
+	return device.FutureOf[*Average](brick, connectorname, GetMovingAverage("getmovingaveragefuture"+device.GenId(), uid, nil))
 }
 
 /*
@@ -18,23 +18,14 @@ func SetMoistureCallbackPeriod(id string, uid uint32, pe *device.Period, handler
 		Uid:        uid,
 		Data:       pe,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetMoistureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetMoistureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetMoistureCallbackPeriod("setmoisturecallbackperiodfuture"+device.GenId(), uid, pe,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetMoistureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetMoistureCallbackPeriod("setmoisturecallbackperiodfuture"+device.GenId(), uid, pe, nil))
 }
 
 // GetMoistureCallbackPeriod creates a subsctiber to get the callback period value.
@@ -49,25 +40,9 @@ func GetMoistureCallbackPeriod(id string, uid uint32, handler func(device.Result
 }
 
 // GetMoistureCallbackPeriodFuture is a future pattern version for a synchronized call of the subsctiber.
-// If an error occur, the result is nil.
-func GetMoistureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Period {
-	future := make(chan *device.Period)
-	defer close(future)
-	sub := GetMoistureCallbackPeriod("getmoisturecallbackperiodfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Period = nil
-			if err == nil {
-				if value, ok := r.(*device.Period); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetMoistureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetMoistureCallbackPeriod("getmoisturecallbackperiodfuture"+device.GenId(), uid, nil))
 }
 
 // MoisturePeriod creates a subscriber for the periodical moisture callback.
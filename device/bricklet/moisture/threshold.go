@@ -18,23 +18,14 @@ func SetMoistureCallbackThreshold(id string, uid uint32, t *device.Threshold16,
 		Uid:        uid,
 		Data:       t,
 		Handler:    handler,
+		Replay:     true,
 		WithPacket: true}.CreateDevice()
 }
 
 // SetTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetMoistureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetMoistureCallbackThreshold("setmoisturecallbackthresholdfuture"+device.GenId(), uid, t,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetMoistureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetMoistureCallbackThreshold("setmoisturecallbackthresholdfuture"+device.GenId(), uid, t, nil))
 }
 
 // GetMoistureCallbackThreshold creates the subscriber to get the callback thresold.
@@ -49,25 +40,9 @@ func GetMoistureCallbackThreshold(id string, uid uint32, handler func(device.Res
 }
 
 // GetMoistureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetMoistureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) *device.Threshold16 {
-	future := make(chan *device.Threshold16)
-	defer close(future)
-	sub := GetMoistureCallbackThreshold("getmoisturecallbackthresholdfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *device.Threshold16 = nil
-			if err == nil {
-				if value, ok := r.(*device.Threshold16); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetMoistureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetMoistureCallbackThreshold("getmoisturecallbackthresholdfuture"+device.GenId(), uid, nil))
 }
 
 // MoistureReached creates a subscriber for the theshold triggered temperature callback.
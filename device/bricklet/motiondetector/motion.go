@@ -30,37 +30,19 @@ func GetMotionDetected(id string, uid uint32, handler func(device.Resulter, erro
 }
 
 // GetMotionDetectedFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetMotionDetectedFuture(brick bricker.Bricker, connectorname string, uid uint32) *Motion {
-	future := make(chan *Motion)
-	defer close(future)
-	sub := GetMotionDetected("getmotiondetectedfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Motion = nil
-			if err == nil {
-				if value, ok := r.(*Motion); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// If an error occur, the result is nil and the error is returned.
+func GetMotionDetectedFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Motion, error) {
+	return device.FutureOf[*Motion](brick, connectorname, GetMotionDetected("getmotiondetectedfuture"+device.GenId(), uid, nil))
 }
 
 // GetMotionDetectedFutureSimple is a easy to use verion of GetMotionDetectedFuture.
-// It returns only true if a motion is detected.
-func GetMotionDetectedFutureSimple(brick bricker.Bricker, connectorname string, uid uint32) bool {
-	m := GetMotionDetectedFuture(brick, connectorname, uid)
-	result := false
-	if m != nil {
-		result = (m.Value == 1)
+// It returns only true if a motion is detected, if an error occur, the error is returned.
+func GetMotionDetectedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	m, err := GetMotionDetectedFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
 	}
-	return result
+	return m.Value == 1, nil
 }
 
 // MotionDetected create the subscriber for the motion detected callback.
@@ -0,0 +1,149 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multitouch
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetElectrodeConfig creates the subscriber to enable or disable electrodes with a bitmask (13bit).
+// The bits 0 to 11 are the electrodes and the bit 12 is the proximity detection.
+// Disabled electrodes are not checked and saves energy, the default is all enabled (ElectrodesAll).
+func SetElectrodeConfig(id string, uid uint32, e *ElectrodeConfig, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetElectrodeConfig"),
+		Fid:        function_set_electrode_config,
+		Uid:        uid,
+		Data:       e,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetElectrodeConfigFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetElectrodeConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *ElectrodeConfig) error {
+	return device.FutureEmpty(brick, connectorname, SetElectrodeConfig("setelectrodeconfigfuture"+device.GenId(), uid, e, nil))
+}
+
+// GetElectrodeConfig creates the subscriber to get the electrode configuration.
+func GetElectrodeConfig(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetElectrodeConfig"),
+		Fid:        function_get_electrode_config,
+		Uid:        uid,
+		Result:     &ElectrodeConfig{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetElectrodeConfigFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetElectrodeConfigFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*ElectrodeConfig, error) {
+	return device.FutureOf[*ElectrodeConfig](brick, connectorname, GetElectrodeConfig("getelectrodeconfigfuture"+device.GenId(), uid, nil))
+}
+
+// SetElectrodeSensitivity creates the subscriber to set the sensitivity of the electrodes (5 - 201).
+// A higher value is more sensitive, the default value is 181.
+// After a change of the sensitivity the electrodes are recalibrated.
+func SetElectrodeSensitivity(id string, uid uint32, s *Sensitivity, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetElectrodeSensitivity"),
+		Fid:        function_set_electrode_sensitivity,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetElectrodeSensitivityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetElectrodeSensitivityFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *Sensitivity) error {
+	return device.FutureEmpty(brick, connectorname, SetElectrodeSensitivity("setelectrodesensitivityfuture"+device.GenId(), uid, s, nil))
+}
+
+// GetElectrodeSensitivity creates the subscriber to get the sensitivity of the electrodes.
+func GetElectrodeSensitivity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetElectrodeSensitivity"),
+		Fid:        function_get_electrode_sensitivity,
+		Uid:        uid,
+		Result:     &Sensitivity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetElectrodeSensitivityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetElectrodeSensitivityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Sensitivity, error) {
+	return device.FutureOf[*Sensitivity](brick, connectorname, GetElectrodeSensitivity("getelectrodesensitivityfuture"+device.GenId(), uid, nil))
+}
+
+// ElectrodeConfig is the bitmask (13bit) of the enabled electrodes (bit 0-11) and the proximity detection (bit 12).
+type ElectrodeConfig struct {
+	Mask uint16
+}
+
+// FromPacket creates from a packet a ElectrodeConfig.
+func (e *ElectrodeConfig) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(e)
+}
+
+// String fullfill the stringer interface.
+func (e *ElectrodeConfig) String() string {
+	txt := "Electrode Config "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Mask: %d (%s)]", e.Mask, misc.Mask16ToString(e.Mask, ElectrodeCount+1, false))
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *ElectrodeConfig) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &ElectrodeConfig{Mask: e.Mask}
+}
+
+// Sensitivity is the sensitivity of the electrodes (5 - 201).
+type Sensitivity struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a Sensitivity.
+func (s *Sensitivity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *Sensitivity) String() string {
+	txt := "Sensitivity "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", s.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *Sensitivity) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &Sensitivity{Value: s.Value}
+}
@@ -0,0 +1,131 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Multi Touch Bricklet.
+package multitouch
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+const (
+	function_get_touch_state           = uint8(1)
+	function_recalibrate               = uint8(2)
+	function_set_electrode_config      = uint8(3)
+	function_get_electrode_config      = uint8(4)
+	function_set_electrode_sensitivity = uint8(6)
+	function_get_electrode_sensitivity = uint8(7)
+	callback_touch_state               = uint8(5)
+	// Electrodes
+	ElectrodeCount = uint8(12)      // number of electrodes (0-11)
+	Proximity      = uint8(12)      // bit of the proximity detection
+	ElectrodesAll  = uint16(0x1fff) // all electrodes and the proximity detection
+)
+
+// GetTouchState creates a subscriber to get the touch state.
+// The bits 0 to 11 are the state of the electrodes, the bit 12 is the proximity detection.
+// For a notification use the TouchStateChanged callback.
+func GetTouchState(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTouchState"),
+		Fid:        function_get_touch_state,
+		Uid:        uid,
+		Result:     &TouchState{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTouchStateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTouchStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*TouchState, error) {
+	return device.FutureOf[*TouchState](brick, connectorname, GetTouchState("gettouchstatefuture"+device.GenId(), uid, nil))
+}
+
+// TouchStateChanged creates a subscriber for the touch state callback.
+// The callback is triggered every time, the touch state changes.
+func TouchStateChanged(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "TouchStateChanged"),
+		Fid:        callback_touch_state,
+		Uid:        uid,
+		Result:     &TouchState{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Recalibrate creates a subscriber to recalibrate the electrodes.
+// Call it, after the electrodes or the covering of them has changed.
+func Recalibrate(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Recalibrate"),
+		Fid:        function_recalibrate,
+		Uid:        uid,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// RecalibrateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func RecalibrateFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, Recalibrate("recalibratefuture"+device.GenId(), uid, nil))
+}
+
+// TouchState is the bitmask (13bit) of the touched electrodes (bit 0-11) and the proximity (bit 12).
+type TouchState struct {
+	Value uint16
+}
+
+// FromPacket creates from a packet a TouchState.
+func (t *TouchState) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// String fullfill the stringer interface.
+func (t *TouchState) String() string {
+	txt := "Touch State "
+	if t == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d (%s), Electrodes: %v, Proximity: %t]",
+			t.Value, misc.Mask16ToString(t.Value, ElectrodeCount+1, false), t.Electrodes(), t.IsProximity())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (t *TouchState) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &TouchState{Value: t.Value}
+}
+
+// IsTouched tests, if the electrode (0-11) is touched.
+func (t *TouchState) IsTouched(electrode uint8) bool {
+	return electrode < ElectrodeCount && t.Value&(1<<electrode) != 0
+}
+
+// IsProximity tests, if the proximity is detected.
+func (t *TouchState) IsProximity() bool {
+	return t.Value&(1<<Proximity) != 0
+}
+
+// Electrodes gives the numbers of all touched electrodes.
+func (t *TouchState) Electrodes() []uint8 {
+	e := make([]uint8, 0, ElectrodeCount)
+	for i := uint8(0); i < ElectrodeCount; i++ {
+		if t.IsTouched(i) {
+			e = append(e, i)
+		}
+	}
+	return e
+}
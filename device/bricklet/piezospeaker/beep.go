@@ -26,19 +26,9 @@ func Beep(id string, uid uint32, b *Beeps, handler func(device.Resulter, error))
 }
 
 // BeepFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func BeepFuture(brick bricker.Bricker, connectorname string, uid uint32, b *Beeps) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := Beep("beepfuture"+device.GenId(), uid, b,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func BeepFuture(brick *bricker.Bricker, connectorname string, uid uint32, b *Beeps) error {
+	return device.FutureEmpty(brick, connectorname, Beep("beepfuture"+device.GenId(), uid, b, nil))
 }
 
 // BeepFinished creates a subscriber which is triggered if a Beep subscriber is finished.
@@ -33,35 +33,19 @@ func Calibrate(id string, uid uint32, handler func(device.Resulter, error)) *dev
 }
 
 // CalibrateFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func CalibrateFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Calibration {
-	future := make(chan *Calibration)
-	defer close(future)
-	sub := Calibrate("calibratefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Calibration = nil
-			if err == nil {
-				if value, ok := r.(*Calibration); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func CalibrateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Calibration, error) {
+	return device.FutureOf[*Calibration](brick, connectorname, Calibrate("calibratefuture"+device.GenId(), uid, nil))
 }
 
 // CalibrateFutureSimple is a simple future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func CalibrateFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	c := CalibrateFuture(brick, connectorname, uid)
-	if c == nil {
-		return false
+// If an error occur, the result is false and the error is returned.
+func CalibrateFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	c, err := CalibrateFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
 	}
-	return c.Done
+	return c.Done, nil
 }
 
 // Calibration is the type for the Calibrate result.
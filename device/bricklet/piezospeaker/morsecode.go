@@ -30,19 +30,9 @@ func MorseCode(id string, uid uint32, m *Morse, handler func(device.Resulter, er
 }
 
 // MorseCodeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func MorseCodeFuture(brick bricker.Bricker, connectorname string, uid uint32, m *Morse) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := MorseCode("morsecodefuture"+device.GenId(), uid, m,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func MorseCodeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *Morse) error {
+	return device.FutureEmpty(brick, connectorname, MorseCode("morsecodefuture"+device.GenId(), uid, m, nil))
 }
 
 // BeepFinished creates a subscriber which is triggered if a Beep subscriber is finished.
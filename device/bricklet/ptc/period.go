@@ -0,0 +1,110 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptc
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetTemperatureCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// TemperaturePeriod is only triggered if the temperature has changed since the last triggering.
+func SetTemperatureCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetTemperatureCallbackPeriod"),
+		Fid:        function_set_temperature_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetTemperatureCallbackPeriod("settemperaturecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetTemperatureCallbackPeriod creates a subscriber to get the callback period value.
+func GetTemperatureCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTemperatureCallbackPeriod"),
+		Fid:        function_get_temperature_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetTemperatureCallbackPeriod("gettemperaturecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetResistanceCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// ResistancePeriod is only triggered if the resistance has changed since the last triggering.
+func SetResistanceCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetResistanceCallbackPeriod"),
+		Fid:        function_set_resistance_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetResistanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetResistanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetResistanceCallbackPeriod("setresistancecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetResistanceCallbackPeriod creates a subscriber to get the callback period value.
+func GetResistanceCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetResistanceCallbackPeriod"),
+		Fid:        function_get_resistance_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetResistanceCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetResistanceCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetResistanceCallbackPeriod("getresistancecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// TemperaturePeriod creates a subscriber for the periodical temperature callback.
+// Is only triggered if the temperature changed, since last triggering.
+func TemperaturePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "TemperaturePeriod"),
+		Fid:        callback_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// ResistancePeriod creates a subscriber for the periodical resistance callback.
+// Is only triggered if the resistance changed, since last triggering.
+func ResistancePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "ResistancePeriod"),
+		Fid:        callback_resistance,
+		Uid:        uid,
+		Result:     &Resistance{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
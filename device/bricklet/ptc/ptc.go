@@ -0,0 +1,198 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the PTC Bricklet.
+package ptc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_temperature                             = uint8(1)
+	function_get_resistance                              = uint8(2)
+	function_set_temperature_callback_period             = uint8(3)
+	function_get_temperature_callback_period             = uint8(4)
+	function_set_resistance_callback_period              = uint8(5)
+	function_get_resistance_callback_period              = uint8(6)
+	function_set_temperature_callback_threshold          = uint8(7)
+	function_get_temperature_callback_threshold          = uint8(8)
+	function_set_resistance_callback_threshold           = uint8(9)
+	function_get_resistance_callback_threshold           = uint8(10)
+	function_set_debounce_period                         = uint8(11)
+	function_get_debounce_period                         = uint8(12)
+	function_set_noise_rejection_filter                  = uint8(17)
+	function_get_noise_rejection_filter                  = uint8(18)
+	function_is_sensor_connected                         = uint8(19)
+	function_set_wire_mode                               = uint8(20)
+	function_get_wire_mode                               = uint8(21)
+	function_set_sensor_connected_callback_configuration = uint8(22)
+	function_get_sensor_connected_callback_configuration = uint8(23)
+	callback_temperature                                 = uint8(13)
+	callback_temperature_reached                         = uint8(14)
+	callback_resistance                                  = uint8(15)
+	callback_resistance_reached                          = uint8(16)
+	callback_sensor_connected                            = uint8(24)
+	// Noise rejection filter
+	FilterOption50Hz = uint8(0) // default
+	FilterOption60Hz = uint8(1)
+	// Wire modes
+	WireMode2 = uint8(2) // default
+	WireMode3 = uint8(3)
+	WireMode4 = uint8(4)
+)
+
+// FilterOptionName results a string representation of the given filter option.
+func FilterOptionName(f uint8) string {
+	switch f {
+	case FilterOption50Hz:
+		return "50Hz"
+	case FilterOption60Hz:
+		return "60Hz"
+	default:
+		return "Unknown"
+	}
+}
+
+// WireModeName results a string representation of the given wire mode.
+func WireModeName(m uint8) string {
+	switch m {
+	case WireMode2:
+		return "2-wire"
+	case WireMode3:
+		return "3-wire"
+	case WireMode4:
+		return "4-wire"
+	default:
+		return "Unknown"
+	}
+}
+
+// GetTemperature creates a subscriber for getting the actual temperature of the connected sensor.
+// The value is only valid, if a sensor is connected (see IsSensorConnected).
+// For periodical values use the TemperaturePeriod callback.
+func GetTemperature(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTemperature"),
+		Fid:        function_get_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTemperatureFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetTemperature("gettemperaturefuture"+device.GenId(), uid, nil))
+}
+
+// GetResistance creates a subscriber for getting the raw resistance of the connected sensor.
+// For periodical values use the ResistancePeriod callback.
+func GetResistance(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetResistance"),
+		Fid:        function_get_resistance,
+		Uid:        uid,
+		Result:     &Resistance{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetResistanceFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetResistanceFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Resistance, error) {
+	return device.FutureOf[*Resistance](brick, connectorname, GetResistance("getresistancefuture"+device.GenId(), uid, nil))
+}
+
+// Temperature type for a single temperature.
+// From -24600 up to 84900 as °C/100, means a temperature of 1234 is 12.34 °C.
+type Temperature struct {
+	Value int32
+}
+
+// FromPacket create from a packet a Temperature.
+func (t *Temperature) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// Float64 convert the temperature from int32 to float64.
+func (t *Temperature) Float64() float64 {
+	f := float64(t.Value) / 100.0
+	return f
+}
+
+// Float32 convert the temperature from int32 to float32.
+func (t *Temperature) Float32() float32 {
+	f := float32(t.Value) / 100.0
+	return f
+}
+
+// String fullfill the stringer interface.
+func (t *Temperature) String() string {
+	txt := "Temperature "
+	if t == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Temperature: %5.2f°C]", t.Value, t.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (t *Temperature) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &Temperature{Value: t.Value}
+}
+
+// Resistance is the raw resistance value of the sensor.
+// It is converted into Ω with Pt100 or Pt1000.
+type Resistance struct {
+	Value int32
+}
+
+// FromPacket create from a packet a Resistance.
+func (r *Resistance) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(r, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(r)
+}
+
+// Pt100 converts the resistance of a Pt100 sensor into Ω.
+func (r *Resistance) Pt100() float64 {
+	return float64(r.Value) * 390.0 / 32768.0
+}
+
+// Pt1000 converts the resistance of a Pt1000 sensor into Ω.
+func (r *Resistance) Pt1000() float64 {
+	return float64(r.Value) * 3900.0 / 32768.0
+}
+
+// String fullfill the stringer interface.
+func (r *Resistance) String() string {
+	txt := "Resistance "
+	if r == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Pt100: %.2f Ω, Pt1000: %.2f Ω]", r.Value, r.Pt100(), r.Pt1000())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (r *Resistance) Copy() device.Resulter {
+	if r == nil {
+		return nil
+	}
+	return &Resistance{Value: r.Value}
+}
@@ -0,0 +1,342 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptc
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+// SetNoiseRejectionFilter creates the subscriber to set the noise rejection filter to 50Hz or 60Hz.
+// Noise from 50Hz or 60Hz power sources (including harmonics) is rejected.
+// The default value is 50Hz (FilterOption50Hz).
+func SetNoiseRejectionFilter(id string, uid uint32, f *Filter, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetNoiseRejectionFilter"),
+		Fid:        function_set_noise_rejection_filter,
+		Uid:        uid,
+		Data:       f,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetNoiseRejectionFilterFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetNoiseRejectionFilterFuture(brick *bricker.Bricker, connectorname string, uid uint32, f *Filter) error {
+	return device.FutureEmpty(brick, connectorname, SetNoiseRejectionFilter("setnoiserejectionfilterfuture"+device.GenId(), uid, f, nil))
+}
+
+// GetNoiseRejectionFilter creates the subscriber to get the noise rejection filter.
+func GetNoiseRejectionFilter(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetNoiseRejectionFilter"),
+		Fid:        function_get_noise_rejection_filter,
+		Uid:        uid,
+		Result:     &Filter{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetNoiseRejectionFilterFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetNoiseRejectionFilterFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Filter, error) {
+	return device.FutureOf[*Filter](brick, connectorname, GetNoiseRejectionFilter("getnoiserejectionfilterfuture"+device.GenId(), uid, nil))
+}
+
+// SetWireMode creates the subscriber to set the wire mode of the sensor (2-, 3- or 4-wire).
+// The wire mode must match the connected sensor.
+// The default value is 2-wire (WireMode2).
+func SetWireMode(id string, uid uint32, m *WireMode, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetWireMode"),
+		Fid:        function_set_wire_mode,
+		Uid:        uid,
+		Data:       m,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetWireModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetWireModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *WireMode) error {
+	return device.FutureEmpty(brick, connectorname, SetWireMode("setwiremodefuture"+device.GenId(), uid, m, nil))
+}
+
+// GetWireMode creates the subscriber to get the wire mode of the sensor.
+func GetWireMode(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetWireMode"),
+		Fid:        function_get_wire_mode,
+		Uid:        uid,
+		Result:     &WireMode{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetWireModeFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetWireModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*WireMode, error) {
+	return device.FutureOf[*WireMode](brick, connectorname, GetWireMode("getwiremodefuture"+device.GenId(), uid, nil))
+}
+
+// IsSensorConnected creates the subscriber to test, if the sensor is connected correctly.
+// If the sensor is not connected or the wire mode is wrong, the result is false.
+func IsSensorConnected(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsSensorConnected"),
+		Fid:        function_is_sensor_connected,
+		Uid:        uid,
+		Result:     &Connected{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsSensorConnectedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsSensorConnectedFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Connected, error) {
+	return device.FutureOf[*Connected](brick, connectorname, IsSensorConnected("issensorconnectedfuture"+device.GenId(), uid, nil))
+}
+
+// IsSensorConnectedFutureSimple calls the IsSensorConnectedFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsSensorConnectedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	c, err := IsSensorConnectedFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return c.Value, nil
+}
+
+// SetSensorConnectedCallbackConfiguration creates the subscriber to enable or disable the sensor connected callback.
+// The default value is disabled.
+func SetSensorConnectedCallbackConfiguration(id string, uid uint32, e *Enabled, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetSensorConnectedCallbackConfiguration"),
+		Fid:        function_set_sensor_connected_callback_configuration,
+		Uid:        uid,
+		Data:       NewEnabledRaw(e),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetSensorConnectedCallbackConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetSensorConnectedCallbackConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *Enabled) error {
+	return device.FutureEmpty(brick, connectorname, SetSensorConnectedCallbackConfiguration("setsensorconnectedcallbackconfigurationfuture"+device.GenId(), uid, e, nil))
+}
+
+// GetSensorConnectedCallbackConfiguration creates the subscriber to get the configuration of the sensor connected callback.
+func GetSensorConnectedCallbackConfiguration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSensorConnectedCallbackConfiguration"),
+		Fid:        function_get_sensor_connected_callback_configuration,
+		Uid:        uid,
+		Result:     &Enabled{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSensorConnectedCallbackConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSensorConnectedCallbackConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, GetSensorConnectedCallbackConfiguration("getsensorconnectedcallbackconfigurationfuture"+device.GenId(), uid, nil))
+}
+
+// SensorConnected creates a subscriber for the sensor connected callback.
+// This callback is triggered, if the sensor is connected or disconnected
+// (see SetSensorConnectedCallbackConfiguration).
+func SensorConnected(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SensorConnected"),
+		Fid:        callback_sensor_connected,
+		Uid:        uid,
+		Result:     &Connected{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Filter is the option of the noise rejection filter.
+type Filter struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a Filter.
+func (f *Filter) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(f, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(f)
+}
+
+// String fullfill the stringer interface.
+func (f *Filter) String() string {
+	txt := "Filter "
+	if f == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", FilterOptionName(f.Value), f.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (f *Filter) Copy() device.Resulter {
+	if f == nil {
+		return nil
+	}
+	return &Filter{Value: f.Value}
+}
+
+// WireMode is the wire mode of the sensor.
+type WireMode struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a WireMode.
+func (m *WireMode) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(m, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(m)
+}
+
+// String fullfill the stringer interface.
+func (m *WireMode) String() string {
+	txt := "Wire Mode "
+	if m == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", WireModeName(m.Value), m.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (m *WireMode) Copy() device.Resulter {
+	if m == nil {
+		return nil
+	}
+	return &WireMode{Value: m.Value}
+}
+
+// Connected is the state of the sensor connection.
+type Connected struct {
+	Value bool // true - connected, false - not connected
+}
+
+// FromPacket converts the packet payload to the Connected type.
+func (c *Connected) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	cr := new(ConnectedRaw)
+	err := p.Payload.Decode(cr)
+	if err == nil {
+		c.FromConnectedRaw(cr)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (c *Connected) String() string {
+	txt := "Connected "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Connected) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Connected{Value: c.Value}
+}
+
+// FromConnectedRaw converts the ConnectedRaw into a Connected.
+func (c *Connected) FromConnectedRaw(cr *ConnectedRaw) {
+	if c == nil || cr == nil {
+		return
+	}
+	c.Value = misc.Uint8ToBool(cr.Value)
+}
+
+// ConnectedRaw is the real de/encoding type for a Connected.
+type ConnectedRaw struct {
+	Value uint8
+}
+
+// NewConnectedRaw creates a new ConnectedRaw from a Connected.
+func NewConnectedRaw(c *Connected) *ConnectedRaw {
+	if c == nil {
+		return nil
+	}
+	return &ConnectedRaw{Value: misc.BoolToUint8(c.Value)}
+}
+
+// Enabled is a type for showing if a callback is enabled or disabled.
+type Enabled struct {
+	Value bool // true - enabled, false - disabled
+}
+
+// FromPacket converts the packet payload to the Enabled type.
+func (e *Enabled) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	er := new(EnabledRaw)
+	err := p.Payload.Decode(er)
+	if err == nil {
+		e.FromEnabledRaw(er)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (e *Enabled) String() string {
+	txt := "Enabled "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %t]", e.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Enabled) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Enabled{Value: e.Value}
+}
+
+// FromEnabledRaw converts the EnabledRaw into a Enabled.
+func (e *Enabled) FromEnabledRaw(er *EnabledRaw) {
+	if e == nil || er == nil {
+		return
+	}
+	e.Value = misc.Uint8ToBool(er.Value)
+}
+
+// EnabledRaw is the real de/encoding type for a Enabled.
+type EnabledRaw struct {
+	Value uint8
+}
+
+// NewEnabledRaw creates a new EnabledRaw from a Enabled.
+func NewEnabledRaw(e *Enabled) *EnabledRaw {
+	if e == nil {
+		return nil
+	}
+	return &EnabledRaw{Value: misc.BoolToUint8(e.Value)}
+}
@@ -0,0 +1,106 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptc
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetTemperatureCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetTemperatureCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetTemperatureCallbackThreshold"),
+		Fid:        function_set_temperature_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetTemperatureCallbackThreshold("settemperaturecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetTemperatureCallbackThreshold creates the subscriber to get the callback thresold.
+func GetTemperatureCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetTemperatureCallbackThreshold"),
+		Fid:        function_get_temperature_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetTemperatureCallbackThreshold("gettemperaturecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetResistanceCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetResistanceCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetResistanceCallbackThreshold"),
+		Fid:        function_set_resistance_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetResistanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetResistanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetResistanceCallbackThreshold("setresistancecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetResistanceCallbackThreshold creates the subscriber to get the callback thresold.
+func GetResistanceCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetResistanceCallbackThreshold"),
+		Fid:        function_get_resistance_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetResistanceCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetResistanceCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetResistanceCallbackThreshold("getresistancecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// TemperatureReached creates a subscriber for the threshold triggered temperature callback.
+func TemperatureReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "TemperatureReached"),
+		Fid:        callback_temperature_reached,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// ResistanceReached creates a subscriber for the threshold triggered resistance callback.
+func ResistanceReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "ResistanceReached"),
+		Fid:        callback_resistance_reached,
+		Uid:        uid,
+		Result:     &Resistance{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
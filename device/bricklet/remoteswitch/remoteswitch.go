@@ -0,0 +1,172 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Remote Switch Bricklet.
+package remoteswitch
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_switching_state = uint8(2)
+	function_set_repeats         = uint8(4)
+	function_get_repeats         = uint8(5)
+	function_switch_socket_a     = uint8(6)
+	function_switch_socket_b     = uint8(7)
+	function_dim_socket_b        = uint8(8)
+	function_switch_socket_c     = uint8(9)
+	callback_switching_done      = uint8(3)
+	// Switch to
+	SwitchToOff = uint8(0)
+	SwitchToOn  = uint8(1)
+	// Switching states
+	SwitchingStateReady = uint8(0)
+	SwitchingStateBusy  = uint8(1)
+)
+
+// SwitchingStateName results a string representation of the given switching state.
+func SwitchingStateName(s uint8) string {
+	switch s {
+	case SwitchingStateReady:
+		return "Ready"
+	case SwitchingStateBusy:
+		return "Busy"
+	default:
+		return "Unknown"
+	}
+}
+
+// GetSwitchingState creates the subscriber to get the switching state.
+// While the bricklet sends a switching command, the state is busy and new commands are ignored.
+// For a notification use the SwitchingDone callback.
+func GetSwitchingState(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetSwitchingState"),
+		Fid:        function_get_switching_state,
+		Uid:        uid,
+		Result:     &SwitchingState{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetSwitchingStateFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetSwitchingStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*SwitchingState, error) {
+	return device.FutureOf[*SwitchingState](brick, connectorname, GetSwitchingState("getswitchingstatefuture"+device.GenId(), uid, nil))
+}
+
+// SwitchingDone creates the subscriber for the switching done callback.
+// There are no result inside, the handler is called, if the switching state changes from busy to ready.
+func SwitchingDone(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SwitchingDone"),
+		Fid:        callback_switching_done,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// SetRepeats creates the subscriber to set the number of repeats of a switching command.
+// Some sockets need more repeats to recognize the command, the default value is 5.
+func SetRepeats(id string, uid uint32, r *Repeats, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetRepeats"),
+		Fid:        function_set_repeats,
+		Uid:        uid,
+		Data:       r,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetRepeatsFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetRepeatsFuture(brick *bricker.Bricker, connectorname string, uid uint32, r *Repeats) error {
+	return device.FutureEmpty(brick, connectorname, SetRepeats("setrepeatsfuture"+device.GenId(), uid, r, nil))
+}
+
+// GetRepeats creates the subscriber to get the number of repeats.
+func GetRepeats(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetRepeats"),
+		Fid:        function_get_repeats,
+		Uid:        uid,
+		Result:     &Repeats{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetRepeatsFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetRepeatsFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Repeats, error) {
+	return device.FutureOf[*Repeats](brick, connectorname, GetRepeats("getrepeatsfuture"+device.GenId(), uid, nil))
+}
+
+// SwitchingState is the state of the switching (ready or busy).
+type SwitchingState struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a SwitchingState.
+func (s *SwitchingState) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(s, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(s)
+}
+
+// String fullfill the stringer interface.
+func (s *SwitchingState) String() string {
+	txt := "Switching State "
+	if s == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %s (%d)]", SwitchingStateName(s.Value), s.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (s *SwitchingState) Copy() device.Resulter {
+	if s == nil {
+		return nil
+	}
+	return &SwitchingState{Value: s.Value}
+}
+
+// Repeats is the number of repeats of a switching command.
+type Repeats struct {
+	Value uint8
+}
+
+// FromPacket creates from a packet a Repeats.
+func (r *Repeats) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(r, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(r)
+}
+
+// String fullfill the stringer interface.
+func (r *Repeats) String() string {
+	txt := "Repeats "
+	if r == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", r.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (r *Repeats) Copy() device.Resulter {
+	if r == nil {
+		return nil
+	}
+	return &Repeats{Value: r.Value}
+}
@@ -0,0 +1,110 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remoteswitch
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SwitchSocketA creates the subscriber to switch a socket of type A on or off.
+// The house code (0 - 31) and the receiver code (0 - 31) are set with the DIP switches of the socket.
+func SwitchSocketA(id string, uid uint32, s *SocketA, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SwitchSocketA"),
+		Fid:        function_switch_socket_a,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SwitchSocketAFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SwitchSocketAFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *SocketA) error {
+	return device.FutureEmpty(brick, connectorname, SwitchSocketA("switchsocketafuture"+device.GenId(), uid, s, nil))
+}
+
+// SwitchSocketB creates the subscriber to switch a socket of type B on or off.
+// The address (0 - 67108863) is learned by the socket, the unit 255 switches all units of the address.
+func SwitchSocketB(id string, uid uint32, s *SocketB, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SwitchSocketB"),
+		Fid:        function_switch_socket_b,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SwitchSocketBFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SwitchSocketBFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *SocketB) error {
+	return device.FutureEmpty(brick, connectorname, SwitchSocketB("switchsocketbfuture"+device.GenId(), uid, s, nil))
+}
+
+// DimSocketB creates the subscriber to dim a socket of type B.
+// The dim value is from 0 (off) up to 15 (full on).
+func DimSocketB(id string, uid uint32, d *DimB, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "DimSocketB"),
+		Fid:        function_dim_socket_b,
+		Uid:        uid,
+		Data:       d,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// DimSocketBFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func DimSocketBFuture(brick *bricker.Bricker, connectorname string, uid uint32, d *DimB) error {
+	return device.FutureEmpty(brick, connectorname, DimSocketB("dimsocketbfuture"+device.GenId(), uid, d, nil))
+}
+
+// SwitchSocketC creates the subscriber to switch a socket of type C on or off.
+// The system code ('A' - 'P') and the device code (1 - 16) are set with the rotary switches of the socket.
+func SwitchSocketC(id string, uid uint32, s *SocketC, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SwitchSocketC"),
+		Fid:        function_switch_socket_c,
+		Uid:        uid,
+		Data:       s,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SwitchSocketCFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SwitchSocketCFuture(brick *bricker.Bricker, connectorname string, uid uint32, s *SocketC) error {
+	return device.FutureEmpty(brick, connectorname, SwitchSocketC("switchsocketcfuture"+device.GenId(), uid, s, nil))
+}
+
+// SocketA is the address of a socket of type A with the new state.
+type SocketA struct {
+	HouseCode    uint8 // 0 - 31
+	ReceiverCode uint8 // 0 - 31
+	SwitchTo     uint8 // SwitchToOff or SwitchToOn
+}
+
+// SocketB is the address of a socket of type B with the new state.
+type SocketB struct {
+	Address  uint32 // 0 - 67108863
+	Unit     uint8  // 0 - 15, 255 for all units
+	SwitchTo uint8  // SwitchToOff or SwitchToOn
+}
+
+// DimB is the address of a dimmable socket of type B with the dim value.
+type DimB struct {
+	Address  uint32 // 0 - 67108863
+	Unit     uint8  // 0 - 15, 255 for all units
+	DimValue uint8  // 0 - 15
+}
+
+// SocketC is the address of a socket of type C with the new state.
+type SocketC struct {
+	SystemCode byte  // 'A' - 'P'
+	DeviceCode uint8 // 1 - 16
+	SwitchTo   uint8 // SwitchToOff or SwitchToOn
+}
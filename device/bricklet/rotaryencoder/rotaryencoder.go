@@ -0,0 +1,185 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Rotary Encoder Bricklet.
+package rotaryencoder
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+	misc "github.com/dirkjabl/bricker/util/miscellaneous"
+)
+
+const (
+	function_get_count                    = uint8(1)
+	function_set_count_callback_period    = uint8(2)
+	function_get_count_callback_period    = uint8(3)
+	function_set_count_callback_threshold = uint8(4)
+	function_get_count_callback_threshold = uint8(5)
+	function_set_debounce_period          = uint8(6)
+	function_get_debounce_period          = uint8(7)
+	function_is_pressed                   = uint8(8)
+	callback_count                        = uint8(9)
+	callback_count_reached                = uint8(10)
+	callback_pressed                      = uint8(11)
+	callback_released                     = uint8(12)
+)
+
+// GetCount creates a subscriber to get the count of the rotary encoder.
+// The count is increased by turning clockwise and decreased by turning counter-clockwise,
+// the encoder has 24 steps per rotation.
+// If reset is true, the count is set to 0 directly after the call.
+// For periodical values use the CountPeriod callback.
+func GetCount(id string, uid uint32, reset bool, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCount"),
+		Fid:        function_get_count,
+		Uid:        uid,
+		Result:     &Count{},
+		Data:       misc.BoolToUint8(reset),
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCountFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCountFuture(brick *bricker.Bricker, connectorname string, uid uint32, reset bool) (*Count, error) {
+	return device.FutureOf[*Count](brick, connectorname, GetCount("getcountfuture"+device.GenId(), uid, reset, nil))
+}
+
+// IsPressed creates a subscriber to get the state of the button of the rotary encoder.
+// For an notification use the Pressed and Released callbacks.
+func IsPressed(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "IsPressed"),
+		Fid:        function_is_pressed,
+		Uid:        uid,
+		Result:     &Button{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// IsPressedFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func IsPressedFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Button, error) {
+	return device.FutureOf[*Button](brick, connectorname, IsPressed("ispressedfuture"+device.GenId(), uid, nil))
+}
+
+// IsPressedFutureSimple calls the IsPressedFuture method with a simple boolean result.
+// If an error occur, the result is false and the error is returned.
+func IsPressedFutureSimple(brick *bricker.Bricker, connectorname string, uid uint32) (bool, error) {
+	b, err := IsPressedFuture(brick, connectorname, uid)
+	if err != nil {
+		return false, err
+	}
+	return b.IsPressed, nil
+}
+
+// Pressed creates the subscriber for the pressed callback.
+// There are no result inside, the handler is called, if the button is pressed.
+func Pressed(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Pressed"),
+		Fid:        callback_pressed,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Released creates the subscriber for the released callback.
+// There are no result inside, the handler is called, if the button is released.
+func Released(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "Released"),
+		Fid:        callback_released,
+		Uid:        uid,
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// Count is the count of the rotary encoder (24 steps per rotation).
+type Count struct {
+	Value int32
+}
+
+// FromPacket creates from a packet a Count.
+func (c *Count) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Count) String() string {
+	txt := "Count "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d]", c.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Count) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Count{Value: c.Value}
+}
+
+// Button is the type for the return of the IsPressed subscriber.
+type Button struct {
+	IsPressed bool
+}
+
+// FromPacket converts the packet payload to the Button type.
+func (b *Button) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(b, p); err != nil {
+		return err
+	}
+	br := new(ButtonRaw)
+	err := p.Payload.Decode(br)
+	if err == nil {
+		b.FromButtonRaw(br)
+	}
+	return err
+}
+
+// String fullfill the stringer interface.
+func (b *Button) String() string {
+	txt := "Button "
+	if b == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[IsPressed: %t]", b.IsPressed)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (b *Button) Copy() device.Resulter {
+	if b == nil {
+		return nil
+	}
+	return &Button{IsPressed: b.IsPressed}
+}
+
+// FromButtonRaw converts a ButtonRaw into a Button.
+func (b *Button) FromButtonRaw(br *ButtonRaw) {
+	if b == nil || br == nil {
+		return
+	}
+	b.IsPressed = misc.Uint8ToBool(br.IsPressed)
+}
+
+// ButtonRaw is the real de/encoding type for a Button.
+type ButtonRaw struct {
+	IsPressed uint8
+}
@@ -0,0 +1,58 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rotaryencoder
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCountCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetCountCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCountCallbackThreshold"),
+		Fid:        function_set_count_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCountCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCountCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetCountCallbackThreshold("setcountcallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetCountCallbackThreshold creates the subscriber to get the callback thresold.
+func GetCountCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCountCallbackThreshold"),
+		Fid:        function_get_count_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCountCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCountCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetCountCallbackThreshold("getcountcallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// CountReached creates a subscriber for the threshold triggered count callback.
+func CountReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CountReached"),
+		Fid:        callback_count_reached,
+		Uid:        uid,
+		Result:     &Count{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
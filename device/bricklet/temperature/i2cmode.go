@@ -28,19 +28,9 @@ func SetI2CMode(id string, uid uint32, m *I2CMode, handler func(device.Resulter,
 }
 
 // SetI2CModeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func SetI2CModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *I2CMode) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := SetI2CMode("seti2cmodefuture"+device.GenId(), uid, m,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func SetI2CModeFuture(brick *bricker.Bricker, connectorname string, uid uint32, m *I2CMode) error {
+	return device.FutureEmpty(brick, connectorname, SetI2CMode("seti2cmodefuture"+device.GenId(), uid, m, nil))
 }
 
 // GetI2CMode creates the subscriber to get the I2C mode.
@@ -55,25 +45,9 @@ func GetI2CMode(id string, uid uint32, handler func(device.Resulter, error)) *de
 }
 
 // GetI2CModeFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetI2CModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) *I2CMode {
-	future := make(chan *I2CMode)
-	defer close(future)
-	sub := GetI2CMode("geti2cmodefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *I2CMode = nil
-			if err == nil {
-				if value, ok := r.(*I2CMode); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetI2CModeFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*I2CMode, error) {
+	return device.FutureOf[*I2CMode](brick, connectorname, GetI2CMode("geti2cmodefuture"+device.GenId(), uid, nil))
 }
 
 // I2C mode type.
@@ -38,25 +38,9 @@ func GetTemperature(id string, uid uint32, handler func(device.Resulter, error))
 }
 
 // GetTemperatureFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Temperature {
-	future := make(chan *Temperature)
-	defer close(future)
-	sub := GetTemperature("gettemperaturefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Temperature = nil
-			if err == nil {
-				if value, ok := r.(*Temperature); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetTemperature("gettemperaturefuture"+device.GenId(), uid, nil))
 }
 
 // Temperature type for a single temperature.
@@ -0,0 +1,96 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temperatureir
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetEmissivity creates the subscriber to set the emissivity of the object surface.
+// The emissivity is given as factor * 65535, a emissivity of 0.1 is 6553.
+// The default value is 65535 (1.0), the value is stored in the EEPROM of the bricklet.
+func SetEmissivity(id string, uid uint32, e *Emissivity, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetEmissivity"),
+		Fid:        function_set_emissivity,
+		Uid:        uid,
+		Data:       e,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetEmissivityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetEmissivityFuture(brick *bricker.Bricker, connectorname string, uid uint32, e *Emissivity) error {
+	return device.FutureEmpty(brick, connectorname, SetEmissivity("setemissivityfuture"+device.GenId(), uid, e, nil))
+}
+
+// GetEmissivity creates the subscriber to get the emissivity of the object surface.
+func GetEmissivity(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetEmissivity"),
+		Fid:        function_get_emissivity,
+		Uid:        uid,
+		Result:     &Emissivity{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetEmissivityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetEmissivityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Emissivity, error) {
+	return device.FutureOf[*Emissivity](brick, connectorname, GetEmissivity("getemissivityfuture"+device.GenId(), uid, nil))
+}
+
+// Emissivity is the emissivity of the object surface as factor * 65535.
+type Emissivity struct {
+	Value uint16
+}
+
+// NewEmissivity creates a Emissivity from a factor (0.0 - 1.0).
+// A factor outside the range is limited to the range.
+func NewEmissivity(factor float64) *Emissivity {
+	if factor < 0 {
+		factor = 0
+	} else if factor > 1 {
+		factor = 1
+	}
+	return &Emissivity{Value: uint16(factor*65535.0 + 0.5)}
+}
+
+// FromPacket creates from a packet a Emissivity.
+func (e *Emissivity) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(e, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(e)
+}
+
+// Float64 converts the emissivity into a factor (0.0 - 1.0).
+func (e *Emissivity) Float64() float64 {
+	return float64(e.Value) / 65535.0
+}
+
+// String fullfill the stringer interface.
+func (e *Emissivity) String() string {
+	txt := "Emissivity "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Factor: %.3f]", e.Value, e.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (e *Emissivity) Copy() device.Resulter {
+	if e == nil {
+		return nil
+	}
+	return &Emissivity{Value: e.Value}
+}
@@ -0,0 +1,110 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temperatureir
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetAmbientTemperatureCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// AmbientTemperaturePeriod is only triggered if the ambient temperature has changed since the last triggering.
+func SetAmbientTemperatureCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAmbientTemperatureCallbackPeriod"),
+		Fid:        function_set_ambient_temperature_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAmbientTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAmbientTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetAmbientTemperatureCallbackPeriod("setambienttemperaturecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetAmbientTemperatureCallbackPeriod creates a subscriber to get the callback period value.
+func GetAmbientTemperatureCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAmbientTemperatureCallbackPeriod"),
+		Fid:        function_get_ambient_temperature_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAmbientTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAmbientTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetAmbientTemperatureCallbackPeriod("getambienttemperaturecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// SetObjectTemperatureCallbackPeriod creates the subscriber to set the callback period.
+// Default value is 0. A value of 0 deactivates the periodical callbacks.
+// ObjectTemperaturePeriod is only triggered if the object temperature has changed since the last triggering.
+func SetObjectTemperatureCallbackPeriod(id string, uid uint32, pe *device.Period, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetObjectTemperatureCallbackPeriod"),
+		Fid:        function_set_object_temperature_callback_period,
+		Uid:        uid,
+		Data:       pe,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetObjectTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetObjectTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32, pe *device.Period) error {
+	return device.FutureEmpty(brick, connectorname, SetObjectTemperatureCallbackPeriod("setobjecttemperaturecallbackperiodfuture"+device.GenId(), uid, pe, nil))
+}
+
+// GetObjectTemperatureCallbackPeriod creates a subscriber to get the callback period value.
+func GetObjectTemperatureCallbackPeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetObjectTemperatureCallbackPeriod"),
+		Fid:        function_get_object_temperature_callback_period,
+		Uid:        uid,
+		Result:     &device.Period{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetObjectTemperatureCallbackPeriodFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetObjectTemperatureCallbackPeriodFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Period, error) {
+	return device.FutureOf[*device.Period](brick, connectorname, GetObjectTemperatureCallbackPeriod("getobjecttemperaturecallbackperiodfuture"+device.GenId(), uid, nil))
+}
+
+// AmbientTemperaturePeriod creates a subscriber for the periodical ambient temperature callback.
+// Is only triggered if the ambient temperature changed, since last triggering.
+func AmbientTemperaturePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AmbientTemperaturePeriod"),
+		Fid:        callback_ambient_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// ObjectTemperaturePeriod creates a subscriber for the periodical object temperature callback.
+// Is only triggered if the object temperature changed, since last triggering.
+func ObjectTemperaturePeriod(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "ObjectTemperaturePeriod"),
+		Fid:        callback_object_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,118 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Temperature IR Bricklet.
+package temperatureir
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+const (
+	function_get_ambient_temperature                    = uint8(1)
+	function_get_object_temperature                     = uint8(2)
+	function_set_emissivity                             = uint8(3)
+	function_get_emissivity                             = uint8(4)
+	function_set_ambient_temperature_callback_period    = uint8(5)
+	function_get_ambient_temperature_callback_period    = uint8(6)
+	function_set_object_temperature_callback_period     = uint8(7)
+	function_get_object_temperature_callback_period     = uint8(8)
+	function_set_ambient_temperature_callback_threshold = uint8(9)
+	function_get_ambient_temperature_callback_threshold = uint8(10)
+	function_set_object_temperature_callback_threshold  = uint8(11)
+	function_get_object_temperature_callback_threshold  = uint8(12)
+	function_set_debounce_period                        = uint8(13)
+	function_get_debounce_period                        = uint8(14)
+	callback_ambient_temperature                        = uint8(15)
+	callback_object_temperature                         = uint8(16)
+	callback_ambient_temperature_reached                = uint8(17)
+	callback_object_temperature_reached                 = uint8(18)
+)
+
+// GetAmbientTemperature creates a subscriber for getting the ambient temperature of the sensor.
+// For periodical values use the AmbientTemperaturePeriod callback.
+func GetAmbientTemperature(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAmbientTemperature"),
+		Fid:        function_get_ambient_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAmbientTemperatureFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAmbientTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetAmbientTemperature("getambienttemperaturefuture"+device.GenId(), uid, nil))
+}
+
+// GetObjectTemperature creates a subscriber for getting the object temperature.
+// The object temperature is the temperature of the surface in view of the sensor,
+// the emissivity of the surface is configured with SetEmissivity.
+// For periodical values use the ObjectTemperaturePeriod callback.
+func GetObjectTemperature(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetObjectTemperature"),
+		Fid:        function_get_object_temperature,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetObjectTemperatureFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetObjectTemperatureFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Temperature, error) {
+	return device.FutureOf[*Temperature](brick, connectorname, GetObjectTemperature("getobjecttemperaturefuture"+device.GenId(), uid, nil))
+}
+
+// Temperature type for a single temperature.
+// The ambient temperature is from -400 up to 1250 and the object temperature from -700 up to 3800
+// as °C/10, means a temperature of 1234 is 123.4 °C.
+type Temperature struct {
+	Value int16
+}
+
+// FromPacket create from a packet a Temperature.
+func (t *Temperature) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(t, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(t)
+}
+
+// Float64 convert the temperature from int16 to float64.
+func (t *Temperature) Float64() float64 {
+	f := float64(t.Value) / 10.0
+	return f
+}
+
+// Float32 convert the temperature from int16 to float32.
+func (t *Temperature) Float32() float32 {
+	f := float32(t.Value) / 10.0
+	return f
+}
+
+// String fullfill the stringer interface.
+func (t *Temperature) String() string {
+	txt := "Temperature "
+	if t == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d, Temperature: %5.1f°C]", t.Value, t.Float64())
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (t *Temperature) Copy() device.Resulter {
+	if t == nil {
+		return nil
+	}
+	return &Temperature{Value: t.Value}
+}
@@ -0,0 +1,106 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package temperatureir
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetAmbientTemperatureCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetAmbientTemperatureCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetAmbientTemperatureCallbackThreshold"),
+		Fid:        function_set_ambient_temperature_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetAmbientTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetAmbientTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetAmbientTemperatureCallbackThreshold("setambienttemperaturecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetAmbientTemperatureCallbackThreshold creates the subscriber to get the callback thresold.
+func GetAmbientTemperatureCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetAmbientTemperatureCallbackThreshold"),
+		Fid:        function_get_ambient_temperature_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetAmbientTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetAmbientTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetAmbientTemperatureCallbackThreshold("getambienttemperaturecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetObjectTemperatureCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetObjectTemperatureCallbackThreshold(id string, uid uint32, t *device.Threshold16, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetObjectTemperatureCallbackThreshold"),
+		Fid:        function_set_object_temperature_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetObjectTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetObjectTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold16) error {
+	return device.FutureEmpty(brick, connectorname, SetObjectTemperatureCallbackThreshold("setobjecttemperaturecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetObjectTemperatureCallbackThreshold creates the subscriber to get the callback thresold.
+func GetObjectTemperatureCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetObjectTemperatureCallbackThreshold"),
+		Fid:        function_get_object_temperature_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold16{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetObjectTemperatureCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetObjectTemperatureCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold16, error) {
+	return device.FutureOf[*device.Threshold16](brick, connectorname, GetObjectTemperatureCallbackThreshold("getobjecttemperaturecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// AmbientTemperatureReached creates a subscriber for the threshold triggered ambient temperature callback.
+func AmbientTemperatureReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "AmbientTemperatureReached"),
+		Fid:        callback_ambient_temperature_reached,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// ObjectTemperatureReached creates a subscriber for the threshold triggered object temperature callback.
+func ObjectTemperatureReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "ObjectTemperatureReached"),
+		Fid:        callback_object_temperature_reached,
+		Uid:        uid,
+		Result:     &Temperature{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -24,25 +24,9 @@ func GetTiltState(id string, uid uint32, handler func(device.Resulter, error)) *
 }
 
 // GetTiltStateFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func GetTiltStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) *TiltState {
-	future := make(chan *TiltState)
-	defer close(future)
-	sub := GetTiltState("gettiltstatefuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *TiltState = nil
-			if err == nil {
-				if value, ok := r.(*TiltState); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func GetTiltStateFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*TiltState, error) {
+	return device.FutureOf[*TiltState](brick, connectorname, GetTiltState("gettiltstatefuture"+device.GenId(), uid, nil))
 }
 
 // EnableTiltStateCallback creates a subscriber to enable the TiltStateChanged callback.
@@ -56,19 +40,9 @@ func EnableTiltStateCallback(id string, uid uint32, handler func(device.Resulter
 }
 
 // EnableTiltStateCallbackFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func EnableTiltStateCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := EnableTiltStateCallback("enabletiltstatecallbackfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func EnableTiltStateCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, EnableTiltStateCallback("enabletiltstatecallbackfuture"+device.GenId(), uid, nil))
 }
 
 // DisableTiltStateCallback creates a subscriber to disable the TiltStateChanged callback.
@@ -82,19 +56,9 @@ func DisableTiltStateCallback(id string, uid uint32, handler func(device.Resulte
 }
 
 // DisableTiltStateCallbackFuture is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is false.
-func DisableTiltStateCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) bool {
-	future := make(chan bool)
-	defer close(future)
-	sub := DisableTiltStateCallback("disabletiltstatecallbackfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			future <- device.IsEmptyResultOk(r, err)
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return false
-	}
-	return <-future
+// If an error occur, the error is returned.
+func DisableTiltStateCallbackFuture(brick *bricker.Bricker, connectorname string, uid uint32) error {
+	return device.FutureEmpty(brick, connectorname, DisableTiltStateCallback("disabletiltstatecallbackfuture"+device.GenId(), uid, nil))
 }
 
 // IsTiltStateCallbackEnabled creates a subscriber for calling, if the TiltStateChanged callback is enabled.
@@ -109,25 +73,9 @@ func IsTiltStateCallbackEnabled(id string, uid uint32, handler func(device.Resul
 }
 
 // IsTiltStateCallbackEnabledFuture is a future pattern version for a synchronized calll of the subscriber.
-// If an error occur, the result is nil.
-func IsTiltStateCallbackEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) *Enabled {
-	future := make(chan *Enabled)
-	defer close(future)
-	sub := IsTiltStateCallbackEnabled("istiltstatecallbackenabledfuture"+device.GenId(), uid,
-		func(r device.Resulter, err error) {
-			var v *Enabled = nil
-			if err == nil {
-				if value, ok := r.(*Enabled); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	return <-future
+// If an error occur, the result is nil and the error is returned.
+func IsTiltStateCallbackEnabledFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Enabled, error) {
+	return device.FutureOf[*Enabled](brick, connectorname, IsTiltStateCallbackEnabled("istiltstatecallbackenabledfuture"+device.GenId(), uid, nil))
 }
 
 // TiltStateChanged creates a subscriber which is called every time the tilt state changed.
@@ -0,0 +1,156 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package voltagecurrent
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// SetConfiguration creates a subscriber to set the configuration of the averaging and the conversion times.
+// The default is 64 samples and 1.1ms conversion time for the voltage and the current.
+func SetConfiguration(id string, uid uint32, c *Configuration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetConfiguration"),
+		Fid:        function_set_configuration,
+		Uid:        uid,
+		Data:       c,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Configuration) error {
+	return device.FutureEmpty(brick, connectorname, SetConfiguration("setconfigurationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetConfiguration creates a subscriber to get the configuration, which was set with SetConfiguration.
+func GetConfiguration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetConfiguration"),
+		Fid:        function_get_configuration,
+		Uid:        uid,
+		Result:     &Configuration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetConfigurationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetConfigurationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Configuration, error) {
+	return device.FutureOf[*Configuration](brick, connectorname, GetConfiguration("getconfigurationfuture"+device.GenId(), uid, nil))
+}
+
+// SetCalibration creates a subscriber to set the calibration of the current.
+// The measured current is multiplied with the gain multiplier and divided by the gain divisor.
+// The calibration is stored in the flash, the default is 1/1.
+func SetCalibration(id string, uid uint32, c *Calibration, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCalibration"),
+		Fid:        function_set_calibration,
+		Uid:        uid,
+		Data:       c,
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCalibrationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCalibrationFuture(brick *bricker.Bricker, connectorname string, uid uint32, c *Calibration) error {
+	return device.FutureEmpty(brick, connectorname, SetCalibration("setcalibrationfuture"+device.GenId(), uid, c, nil))
+}
+
+// GetCalibration creates a subscriber to get the calibration, which was set with SetCalibration.
+func GetCalibration(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCalibration"),
+		Fid:        function_get_calibration,
+		Uid:        uid,
+		Result:     &Calibration{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCalibrationFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCalibrationFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Calibration, error) {
+	return device.FutureOf[*Calibration](brick, connectorname, GetCalibration("getcalibrationfuture"+device.GenId(), uid, nil))
+}
+
+// Configuration is the configuration of the averaging and the conversion times.
+type Configuration struct {
+	Averaging             uint8
+	VoltageConversionTime uint8
+	CurrentConversionTime uint8
+}
+
+// FromPacket creates from a packet a Configuration.
+func (c *Configuration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Configuration) String() string {
+	txt := "Configuration "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Averaging: %s (%d), Voltage Conversion Time: %s (%d), Current Conversion Time: %s (%d)]",
+			AveragingName(c.Averaging), c.Averaging,
+			ConversionTimeName(c.VoltageConversionTime), c.VoltageConversionTime,
+			ConversionTimeName(c.CurrentConversionTime), c.CurrentConversionTime)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Configuration) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Configuration{
+		Averaging:             c.Averaging,
+		VoltageConversionTime: c.VoltageConversionTime,
+		CurrentConversionTime: c.CurrentConversionTime}
+}
+
+// Calibration is the gain of the current measurement (multiplier/divisor).
+type Calibration struct {
+	GainMultiplier uint16
+	GainDivisor    uint16
+}
+
+// FromPacket creates from a packet a Calibration.
+func (c *Calibration) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(c, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(c)
+}
+
+// String fullfill the stringer interface.
+func (c *Calibration) String() string {
+	txt := "Calibration "
+	if c == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Gain Multiplier: %d, Gain Divisor: %d]", c.GainMultiplier, c.GainDivisor)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (c *Calibration) Copy() device.Resulter {
+	if c == nil {
+		return nil
+	}
+	return &Calibration{GainMultiplier: c.GainMultiplier, GainDivisor: c.GainDivisor}
+}
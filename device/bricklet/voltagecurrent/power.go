@@ -0,0 +1,67 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package voltagecurrent
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// GetPower creates a subscriber to get the measured power (mW).
+// For periodical values use the PowerPeriod callback.
+func GetPower(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPower"),
+		Fid:        function_get_power,
+		Uid:        uid,
+		Result:     &Power{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPowerFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPowerFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Power, error) {
+	return device.FutureOf[*Power](brick, connectorname, GetPower("getpowerfuture"+device.GenId(), uid, nil))
+}
+
+// Power is the type of the measured power in mW.
+type Power struct {
+	Value int32 // mW
+}
+
+// FromPacket creates from a packet a Power.
+func (w *Power) FromPacket(p *packet.Packet) error {
+	if err := device.CheckForFromPacket(w, p); err != nil {
+		return err
+	}
+	return p.Payload.Decode(w)
+}
+
+// String fullfill the stringer interface.
+func (w *Power) String() string {
+	txt := "Power "
+	if w == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Value: %d mW]", w.Value)
+	}
+	return txt
+}
+
+// Copy creates a copy of the content.
+func (w *Power) Copy() device.Resulter {
+	if w == nil {
+		return nil
+	}
+	return &Power{Value: w.Value}
+}
+
+// Float64 converts the power value (mW) into W.
+func (w *Power) Float64() float64 {
+	return float64(w.Value) / 1000.0
+}
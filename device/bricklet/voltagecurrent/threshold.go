@@ -0,0 +1,154 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package voltagecurrent
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+)
+
+// SetCurrentCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetCurrentCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetCurrentCallbackThreshold"),
+		Fid:        function_set_current_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetCurrentCallbackThreshold("setcurrentcallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetCurrentCallbackThreshold creates the subscriber to get the callback thresold.
+func GetCurrentCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetCurrentCallbackThreshold"),
+		Fid:        function_get_current_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetCurrentCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetCurrentCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetCurrentCallbackThreshold("getcurrentcallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetVoltageCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetVoltageCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetVoltageCallbackThreshold"),
+		Fid:        function_set_voltage_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetVoltageCallbackThreshold("setvoltagecallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetVoltageCallbackThreshold creates the subscriber to get the callback thresold.
+func GetVoltageCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetVoltageCallbackThreshold"),
+		Fid:        function_get_voltage_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetVoltageCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetVoltageCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetVoltageCallbackThreshold("getvoltagecallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// SetPowerCallbackThreshold creates the subscriber to set the callback thresold.
+// Default value is ('x', 0, 0).
+func SetPowerCallbackThreshold(id string, uid uint32, t *device.Threshold32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "SetPowerCallbackThreshold"),
+		Fid:        function_set_power_callback_threshold,
+		Uid:        uid,
+		Data:       t,
+		Handler:    handler,
+		Replay:     true,
+		WithPacket: true}.CreateDevice()
+}
+
+// SetPowerCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the error is returned.
+func SetPowerCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32, t *device.Threshold32) error {
+	return device.FutureEmpty(brick, connectorname, SetPowerCallbackThreshold("setpowercallbackthresholdfuture"+device.GenId(), uid, t, nil))
+}
+
+// GetPowerCallbackThreshold creates the subscriber to get the callback thresold.
+func GetPowerCallbackThreshold(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "GetPowerCallbackThreshold"),
+		Fid:        function_get_power_callback_threshold,
+		Uid:        uid,
+		Result:     &device.Threshold32{},
+		Handler:    handler,
+		WithPacket: true}.CreateDevice()
+}
+
+// GetPowerCallbackThresholdFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetPowerCallbackThresholdFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*device.Threshold32, error) {
+	return device.FutureOf[*device.Threshold32](brick, connectorname, GetPowerCallbackThreshold("getpowercallbackthresholdfuture"+device.GenId(), uid, nil))
+}
+
+// CurrentReached creates a subscriber for the threshold triggered current callback.
+func CurrentReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "CurrentReached"),
+		Fid:        callback_current_reached,
+		Uid:        uid,
+		Result:     &Current{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// VoltageReached creates a subscriber for the threshold triggered voltage callback.
+func VoltageReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "VoltageReached"),
+		Fid:        callback_voltage_reached,
+		Uid:        uid,
+		Result:     &Voltage{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
+
+// PowerReached creates a subscriber for the threshold triggered power callback.
+func PowerReached(id string, uid uint32, handler func(device.Resulter, error)) *device.Device {
+	return device.Generator{
+		Id:         device.FallbackId(id, "PowerReached"),
+		Fid:        callback_power_reached,
+		Uid:        uid,
+		Result:     &Power{},
+		Handler:    handler,
+		IsCallback: true,
+		WithPacket: false}.CreateDevice()
+}
@@ -0,0 +1,102 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Collection of subscriber for the Voltage/Current Bricklet.
+package voltagecurrent
+
+const (
+	function_get_current                    = uint8(1)
+	function_get_voltage                    = uint8(2)
+	function_get_power                      = uint8(3)
+	function_set_configuration              = uint8(4)
+	function_get_configuration              = uint8(5)
+	function_set_calibration                = uint8(6)
+	function_get_calibration                = uint8(7)
+	function_set_current_callback_period    = uint8(8)
+	function_get_current_callback_period    = uint8(9)
+	function_set_voltage_callback_period    = uint8(10)
+	function_get_voltage_callback_period    = uint8(11)
+	function_set_power_callback_period      = uint8(12)
+	function_get_power_callback_period      = uint8(13)
+	function_set_current_callback_threshold = uint8(14)
+	function_get_current_callback_threshold = uint8(15)
+	function_set_voltage_callback_threshold = uint8(16)
+	function_get_voltage_callback_threshold = uint8(17)
+	function_set_power_callback_threshold   = uint8(18)
+	function_get_power_callback_threshold   = uint8(19)
+	function_set_debounce_period            = uint8(20)
+	function_get_debounce_period            = uint8(21)
+	callback_current                        = uint8(22)
+	callback_voltage                        = uint8(23)
+	callback_power                          = uint8(24)
+	callback_current_reached                = uint8(25)
+	callback_voltage_reached                = uint8(26)
+	callback_power_reached                  = uint8(27)
+	// Averaging (number of samples)
+	Averaging1    = uint8(0)
+	Averaging4    = uint8(1)
+	Averaging16   = uint8(2)
+	Averaging64   = uint8(3)
+	Averaging128  = uint8(4)
+	Averaging256  = uint8(5)
+	Averaging512  = uint8(6)
+	Averaging1024 = uint8(7)
+	// Conversion times
+	ConversionTime140us  = uint8(0)
+	ConversionTime204us  = uint8(1)
+	ConversionTime332us  = uint8(2)
+	ConversionTime588us  = uint8(3)
+	ConversionTime1100us = uint8(4)
+	ConversionTime2116us = uint8(5)
+	ConversionTime4156us = uint8(6)
+	ConversionTime8244us = uint8(7)
+)
+
+// AveragingName results a string representation of the given averaging.
+func AveragingName(a uint8) string {
+	switch a {
+	case Averaging1:
+		return "1 Sample"
+	case Averaging4:
+		return "4 Samples"
+	case Averaging16:
+		return "16 Samples"
+	case Averaging64:
+		return "64 Samples"
+	case Averaging128:
+		return "128 Samples"
+	case Averaging256:
+		return "256 Samples"
+	case Averaging512:
+		return "512 Samples"
+	case Averaging1024:
+		return "1024 Samples"
+	default:
+		return "Unknown"
+	}
+}
+
+// ConversionTimeName results a string representation of the given conversion time.
+func ConversionTimeName(ct uint8) string {
+	switch ct {
+	case ConversionTime140us:
+		return "140µs"
+	case ConversionTime204us:
+		return "204µs"
+	case ConversionTime332us:
+		return "332µs"
+	case ConversionTime588us:
+		return "588µs"
+	case ConversionTime1100us:
+		return "1.1ms"
+	case ConversionTime2116us:
+		return "2.116ms"
+	case ConversionTime4156us:
+		return "4.156ms"
+	case ConversionTime8244us:
+		return "8.244ms"
+	default:
+		return "Unknown"
+	}
+}
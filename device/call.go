@@ -0,0 +1,59 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"context"
+	"github.com/dirkjabl/bricker"
+)
+
+// Call is a synchronized call of the device (subscriber) over the bricker.
+// It waits for the result, until the context is done.
+// The handler of the device will not be called, the result and the error are returned.
+// If the context is done first, the error is a bricker timeout or canceled error.
+func Call(ctx context.Context, brick *bricker.Bricker, dest interface{}, d *Device) (Resulter, error) {
+	ev, err := brick.CallSubscriber(ctx, d, dest)
+	if err != nil {
+		return nil, err
+	}
+	return d.FromEvent(ev)
+}
+
+// Future is a synchronized call of the device (subscriber) over the bricker with the timeout of the bricker.
+// It is the base of all future pattern versions of the subscriber.
+func Future(brick *bricker.Bricker, connectorname string, d *Device) (Resulter, error) {
+	ctx, cancel := brick.TimeoutContext()
+	defer cancel()
+	return Call(ctx, brick, connectorname, d)
+}
+
+// FutureOf is the typed version of Future, the result is converted into the result type T of the subscriber.
+// If an error occur or the result has not the type T, the result is the zero value of T and the error is returned.
+//
+//	t, err := device.FutureOf[*temperature.Temperature](brick, "local", temperature.GetTemperature("", uid, nil))
+func FutureOf[T Resulter](brick *bricker.Bricker, connectorname string, d *Device) (T, error) {
+	var zero T
+	result, err := Future(brick, connectorname, d)
+	if err != nil {
+		return zero, err
+	}
+	if v, ok := result.(T); ok {
+		return v, nil
+	}
+	return zero, NewDeviceError(ErrorWrongResultType)
+}
+
+// FutureEmpty is the version of Future for subscriber with an empty result (like all setters).
+// The result is the error of the call, nil means the call succeeded.
+func FutureEmpty(brick *bricker.Bricker, connectorname string, d *Device) error {
+	_, err := FutureOf[*EmptyResult](brick, connectorname, d)
+	return err
+}
+
+// FutureGenerator creates the subscriber of the generator and makes a synchronized call with FutureOf.
+// The handler of the generator will not be called.
+func FutureGenerator[T Resulter](brick *bricker.Bricker, connectorname string, g Generator) (T, error) {
+	return FutureOf[T](brick, connectorname, g.CreateDevice())
+}
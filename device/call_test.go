@@ -0,0 +1,67 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/util/hash"
+	"testing"
+)
+
+func newVirtualBricker(t *testing.T) (*bricker.Bricker, *virtual.Virtual) {
+	b := bricker.New()
+	v := virtual.New()
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 1), func(e *event.Event) *event.Event {
+		return event.NewPacket(packet.NewSimpleHeaderPayload(1, 1, true, &Period{Value: 1234}))
+	})
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 2), func(e *event.Event) *event.Event {
+		return event.NewPacket(packet.NewSimpleHeaderOnly(1, 2, true))
+	})
+	if err := b.Attach(v, "virtual"); err != nil {
+		t.Fatalf("Error: attach failed (%s).", err)
+	}
+	return b, v
+}
+
+func TestFutureOf(t *testing.T) {
+	b, v := newVirtualBricker(t)
+	defer b.Done()
+	defer v.Done()
+	p, err := FutureOf[*Period](b, "virtual",
+		Generator{Uid: 1, Fid: 1, Result: &Period{}, WithPacket: true}.CreateDevice())
+	if err != nil {
+		t.Fatalf("Error TestFutureOf: call failed (%s).", err)
+	}
+	if p.Value != 1234 {
+		t.Fatalf("Error TestFutureOf: wrong result (%d != 1234).", p.Value)
+	}
+	_, err = FutureOf[*Debounce](b, "virtual",
+		Generator{Uid: 1, Fid: 1, Result: &Period{}, WithPacket: true}.CreateDevice())
+	if e, ok := err.(DeviceError); !ok || e.Code != ErrorWrongResultType {
+		t.Fatalf("Error TestFutureOf: wrong result type not detected (%v).", err)
+	}
+}
+
+func TestFutureEmpty(t *testing.T) {
+	b, v := newVirtualBricker(t)
+	defer b.Done()
+	defer v.Done()
+	if err := FutureEmpty(b, "virtual", Generator{Uid: 1, Fid: 2, WithPacket: true}.CreateDevice()); err != nil {
+		t.Fatalf("Error TestFutureEmpty: call failed (%s).", err)
+	}
+}
+
+func TestFutureGenerator(t *testing.T) {
+	b, v := newVirtualBricker(t)
+	defer b.Done()
+	defer v.Done()
+	p, err := FutureGenerator[*Period](b, "virtual", Generator{Uid: 1, Fid: 1, Result: &Period{}, WithPacket: true})
+	if err != nil || p.Value != 1234 {
+		t.Fatalf("Error TestFutureGenerator: wrong result (%v, %s).", p, err)
+	}
+}
@@ -112,25 +112,31 @@ func FallbackId(id, fb string) string {
 
 // Notify process about the result event.
 // The handler routine (callback or event listner) gets only an error value.
-// The result of the event is in the resulter value of the device.
+// The result of the event is a copy of the resulter value of the device,
+// so a device could be notified from many go routines at the same time.
 func (d *Device) Notify(e *event.Event) {
 	if d == nil {
 		return
 	}
+	d.Handler()(d.FromEvent(e))
+}
+
+// FromEvent converts the event into a copy of the resulter value of the device.
+// If the event has an error or do not match the subscription, the result is nil and the error.
+func (d *Device) FromEvent(e *event.Event) (Resulter, error) {
 	if e == nil {
-		d.Handler()(nil, NewDeviceError(ErrorNoEvent))
-		return
+		return nil, NewDeviceError(ErrorNoEvent)
 	}
 	var err error = e.Err
 	if e.Packet != nil && e.Err == nil && e.Packet.Head.FunctionID == d.Subscription().FunctionID {
-		err = d.Result().FromPacket(e.Packet)
-		d.Handler()(d.Result().Copy(), err)
-	} else {
-		if err == nil {
-			err = NewDeviceError(ErrorNotMatchingSubscription)
-		}
-		d.Handler()(nil, err)
+		r := d.Result().Copy()
+		err = r.FromPacket(e.Packet)
+		return r, err
+	}
+	if err == nil {
+		err = NewDeviceError(ErrorNotMatchingSubscription)
 	}
+	return nil, err
 }
 
 // String fullfill the stringer interface.
@@ -22,6 +22,8 @@ type Generator struct {
 	Result     Resulter              // Result object for the result of the callback, it have to fullfill the Resulter interface.
 	Handler    func(Resulter, error) // The callback/event handler function to call on an event.
 	IsCallback bool                  // This is a callback and comes often, not only once.
+	Replay     bool                  // The request is sent again after a reconnect (a callback period, threshold or debounce setter).
+	ReplayKey  uint8                 // Number of payload bytes (like the sensor of a setter), which select a channel of the function.
 	WithPacket bool                  // This subscriber should create a calling (to send) packet.
 }
 
@@ -35,6 +37,11 @@ If WithPacket is false, no packet for sending to the device will be created.
 When a packet is created, it will only have data inside the payload, if Data is filled.
 
 The Result type of the subscriber will be EmptyResult if no Result is given.
+
+If Replay is true, the bricker sends the packet again after a reconnect of the connector,
+so the configuration of the callbacks survives a restart of the brick daemon.
+The bricker keeps the latest packet per function, with ReplayKey the leading payload bytes
+(like the sensor) select a channel of the function and every channel keeps his own packet.
 */
 func (g Generator) CreateDevice() *Device {
 	id := FallbackId(g.Id, "Device")
@@ -51,6 +58,8 @@ func (g Generator) CreateDevice() *Device {
 		r = &EmptyResult{}
 	}
 	sub := subscription.New(hash.ChoosenFunctionIDUid, g.Uid, g.Fid, p, g.IsCallback)
+	sub.Replay = g.Replay
+	sub.ReplayKey = g.ReplayKey
 	return NewSubscriptionResulterHandler(id, sub, r, g.Handler)
 }
 
@@ -60,7 +69,7 @@ func (g Generator) String() string {
 	txt += fmt.Sprintf("Id: %s, UID: %d, Function ID: %d, ", g.Id, g.Uid, g.Fid)
 	txt += fmt.Sprintf("Has Data: %t, ", (g.Data != nil))
 	txt += fmt.Sprintf("Has Resulter: %t, ", (g.Result != nil))
-	txt += fmt.Sprintf("Is Callback: %t, With Packet: %t", g.IsCallback, g.WithPacket)
+	txt += fmt.Sprintf("Is Callback: %t, Replay: %t, With Packet: %t", g.IsCallback, g.Replay, g.WithPacket)
 	txt += "]"
 	return txt
 }
@@ -29,27 +29,10 @@ func GetIdentity(id string, uid uint32, handler func(device.Resulter, error)) *d
 		WithPacket: true}.CreateDevice()
 }
 
-// Future is a future pattern version for a synchronized call of the subscriber.
-// If an error occur, the result is nil.
-func GetIdentityFuture(brick bricker.Bricker, connectorname string, uid uint32) *Identity {
-	future := make(chan *Identity)
-	defer close(future)
-	sub := GetIdentity("getidentityfuture", uid,
-		func(r device.Resulter, err error) {
-			var v *Identity = nil
-			if err == nil {
-				if value, ok := r.(*Identity); ok {
-					v = value
-				}
-			}
-			future <- v
-		})
-	err := brick.Subscribe(sub, connectorname)
-	if err != nil {
-		return nil
-	}
-	v := <-future
-	return v
+// GetIdentityFuture is a future pattern version for a synchronized call of the subscriber.
+// If an error occur, the result is nil and the error is returned.
+func GetIdentityFuture(brick *bricker.Bricker, connectorname string, uid uint32) (*Identity, error) {
+	return device.FutureOf[*Identity](brick, connectorname, GetIdentity("getidentityfuture"+device.GenId(), uid, nil))
 }
 
 // Result type for a getidentity subscriber.
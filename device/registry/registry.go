@@ -0,0 +1,304 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+The registry collects all devices of the connectors of a bricker.
+
+The registry enumerates the devices of the connectors and tracks the enumeration callbacks
+(available, newly connected and disconnected devices).
+For every known device the connector is registered in the bricker,
+so a subscriber with the uid of a device as destination goes to the right connector.
+After a reconnect of a connector, the registry enumerates the devices of the connector again.
+*/
+package registry
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/enumerate"
+	"github.com/dirkjabl/bricker/device/name"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/base58"
+	"github.com/dirkjabl/bricker/subscription"
+	"sort"
+	"sync"
+)
+
+// Entry is a known device with the connector, over which the device is reachable.
+type Entry struct {
+	Connector   string
+	Enumeration *enumerate.Enumeration
+}
+
+// Uid returns the uid of the device as uint32.
+func (e *Entry) Uid() uint32 {
+	if e == nil {
+		return 0
+	}
+	return e.Enumeration.IntUid()
+}
+
+// Name returns the name of the device type.
+func (e *Entry) Name() string {
+	if e == nil {
+		return ""
+	}
+	return name.Name(e.Enumeration.DeviceIdentifer)
+}
+
+// Copy creates a copy of the entry.
+func (e *Entry) Copy() *Entry {
+	if e == nil {
+		return nil
+	}
+	return &Entry{
+		Connector:   e.Connector,
+		Enumeration: e.Enumeration.Copy().(*enumerate.Enumeration)}
+}
+
+// String fullfill the stringer interface.
+func (e *Entry) String() string {
+	txt := "Entry "
+	if e == nil {
+		txt += "[nil]"
+	} else {
+		txt += fmt.Sprintf("[Connector: %s, %s]", e.Connector, e.Enumeration)
+	}
+	return txt
+}
+
+// Registry holds all known devices of the connectors of a bricker.
+type Registry struct {
+	id       string
+	lock     *sync.RWMutex
+	brick    *bricker.Bricker
+	entries  map[uint32]*Entry
+	subs     map[string]*enumerator
+	state    *reconnector
+	watchers map[string]func(*Entry)
+}
+
+// New creates a empty registry for the bricker.
+// Every registry gets an own id, so many registries could track the same connector.
+func New(brick *bricker.Bricker) *Registry {
+	return &Registry{
+		id:       "registry" + device.GenId(),
+		lock:     new(sync.RWMutex),
+		brick:    brick,
+		entries:  make(map[uint32]*Entry),
+		subs:     make(map[string]*enumerator),
+		watchers: make(map[string]func(*Entry))}
+}
+
+// Enumerate sends a enumerate request over the named connector and
+// tracks the enumeration callbacks of this connector.
+// A second call for the same connector enumerates the devices again.
+// After a reconnect of the connector, the devices are enumerated again.
+func (r *Registry) Enumerate(connectorname string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.state == nil {
+		r.state = &reconnector{id: r.id + "/state", registry: r}
+		if err := r.brick.SubscribeState(r.state); err != nil {
+			r.state = nil
+			return err
+		}
+	}
+	if s, ok := r.subs[connectorname]; ok {
+		r.brick.Unsubscribe(s)
+	}
+	s := &enumerator{
+		id:        r.id + "/" + connectorname,
+		connector: connectorname,
+		sub:       enumerate.Enumerate("", false, nil).Subscription(),
+		registry:  r}
+	if err := r.brick.Subscribe(s, connectorname); err != nil {
+		delete(r.subs, connectorname)
+		return err
+	}
+	r.subs[connectorname] = s
+	return nil
+}
+
+// EnumerateAll enumerates the devices of all attached connectors of the bricker.
+func (r *Registry) EnumerateAll() error {
+	for _, n := range r.brick.Connectors() {
+		if err := r.Enumerate(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Done stops the tracking of the enumeration callbacks.
+// The known devices stay in the registry and in the bricker.
+func (r *Registry) Done() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for n, s := range r.subs {
+		r.brick.Unsubscribe(s)
+		delete(r.subs, n)
+	}
+	if r.state != nil {
+		r.brick.UnsubscribeState(r.state)
+		r.state = nil
+	}
+}
+
+// Internal method: tracks tests, if the registry tracks the enumeration callbacks of the connector.
+func (r *Registry) tracks(connectorname string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	_, ok := r.subs[connectorname]
+	return ok
+}
+
+// Get returns the device with the uid or nil, if the device is not known.
+func (r *Registry) Get(uid uint32) *Entry {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.entries[uid].Copy()
+}
+
+// GetBase58 returns the device with the base58 uid (like "6DbsDo") or nil, if the device is not known.
+func (r *Registry) GetBase58(uid string) *Entry {
+	return r.Get(base58.Convert32(base58.DecodeString(uid)))
+}
+
+// Devices returns all known devices, sorted by the uid.
+func (r *Registry) Devices() []*Entry {
+	return r.filter(func(e *Entry) bool { return true })
+}
+
+// DevicesOf returns all known devices with the device identifer (like 216 for temperature bricklets),
+// sorted by the uid.
+func (r *Registry) DevicesOf(deviceidentifer uint16) []*Entry {
+	return r.filter(func(e *Entry) bool { return e.Enumeration.Is(deviceidentifer) })
+}
+
+// DevicesAt returns all known devices of the connector, sorted by the uid.
+func (r *Registry) DevicesAt(connectorname string) []*Entry {
+	return r.filter(func(e *Entry) bool { return e.Connector == connectorname })
+}
+
+// Watch registers a handler for the changes of the registry.
+// The handler gets every enumeration callback as entry.
+// A disconnected device is removed from the registry, before the handler is called.
+func (r *Registry) Watch(id string, handler func(*Entry)) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.watchers[id]; ok {
+		return bricker.NewError(bricker.ErrorSubscriberExists)
+	}
+	r.watchers[id] = handler
+	return nil
+}
+
+// Unwatch releases the handler with the id.
+func (r *Registry) Unwatch(id string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.watchers[id]; !ok {
+		return bricker.NewError(bricker.ErrorNoSubscriberToRelease)
+	}
+	delete(r.watchers, id)
+	return nil
+}
+
+// Internal method: filter returns copies of all entries, which match, sorted by the uid.
+func (r *Registry) filter(match func(*Entry) bool) []*Entry {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	uids := make([]int, 0, len(r.entries))
+	for uid, e := range r.entries {
+		if match(e) {
+			uids = append(uids, int(uid))
+		}
+	}
+	sort.Ints(uids)
+	entries := make([]*Entry, len(uids))
+	for i, uid := range uids {
+		entries[i] = r.entries[uint32(uid)].Copy()
+	}
+	return entries
+}
+
+// Internal method: update stores the enumeration of the connector and notifies the watchers.
+func (r *Registry) update(connectorname string, en *enumerate.Enumeration) {
+	e := &Entry{Connector: connectorname, Enumeration: en}
+	uid := e.Uid()
+	r.lock.Lock()
+	if en.EnumerationType == enumerate.EnumerationTypeDisconneted {
+		if old, ok := r.entries[uid]; ok && old.Connector == connectorname {
+			delete(r.entries, uid)
+			r.brick.UnregisterUid(uid)
+		}
+	} else {
+		r.entries[uid] = e
+		r.brick.RegisterUid(uid, connectorname)
+	}
+	watchers := make([]func(*Entry), 0, len(r.watchers))
+	for _, w := range r.watchers {
+		watchers = append(watchers, w)
+	}
+	r.lock.Unlock()
+	for _, w := range watchers {
+		w(e.Copy())
+	}
+}
+
+// Internal type: enumerator is the subscriber for the enumeration callbacks of one connector.
+type enumerator struct {
+	id        string
+	connector string
+	sub       *subscription.Subscription
+	registry  *Registry
+}
+
+// Id returns the id of the subscriber.
+func (s *enumerator) Id() string {
+	return s.id
+}
+
+// Subscription returns the subscription for the enumeration callbacks.
+func (s *enumerator) Subscription() *subscription.Subscription {
+	return s.sub
+}
+
+// Notify takes the enumeration callbacks of the connector and updates the registry.
+func (s *enumerator) Notify(e *event.Event) {
+	if e == nil || e.Err != nil || e.ConnectorName != s.connector {
+		return // the enumeration callbacks of other connectors are handled by their enumerator
+	}
+	en := &enumerate.Enumeration{}
+	if err := en.FromPacket(e.Packet); err != nil {
+		return
+	}
+	s.registry.update(s.connector, en)
+}
+
+// Internal type: reconnector is the state subscriber, which enumerates the devices of a connector after a reconnect.
+type reconnector struct {
+	id       string
+	registry *Registry
+}
+
+// Id returns the id of the subscriber.
+func (s *reconnector) Id() string {
+	return s.id
+}
+
+// Subscription is not used by a state subscriber.
+func (s *reconnector) Subscription() *subscription.Subscription {
+	return nil
+}
+
+// Notify enumerates the devices of a reconnected connector again.
+func (s *reconnector) Notify(e *event.Event) {
+	if e == nil || e.State != event.StateReconnected || !s.registry.tracks(e.ConnectorName) {
+		return
+	}
+	s.registry.Enumerate(e.ConnectorName)
+}
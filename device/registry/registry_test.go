@@ -0,0 +1,152 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package registry
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/device/enumerate"
+	"github.com/dirkjabl/bricker/device/identity"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/base58"
+	"github.com/dirkjabl/bricker/net/packet"
+	"testing"
+	"time"
+)
+
+// callback creates the enumeration callback of a device.
+func callback(uid string, deviceidentifer uint16, enumerationtype uint8) *event.Event {
+	en := &enumerate.Enumeration{
+		Identity:        identity.Identity{Position: 'a', DeviceIdentifer: deviceidentifer},
+		EnumerationType: enumerationtype}
+	copy(en.Uid[:], uid)
+	return event.NewPacket(packet.NewSimpleHeaderPayload(en.IntUid(), 253, false, en))
+}
+
+// stack creates a virtual connector, which answers the enumerate request with the device.
+func stack(uid string, deviceidentifer uint16) *virtual.Virtual {
+	v := virtual.New()
+	v.AttachFallbackGenerator(func(e *event.Event) *event.Event {
+		if e.Packet.Head.FunctionID != 254 {
+			return nil
+		}
+		return callback(uid, deviceidentifer, enumerate.EnumerationTypeAvailable)
+	})
+	return v
+}
+
+// wait waits for the next change of the registry.
+func wait(t *testing.T, changes chan *Entry) *Entry {
+	select {
+	case e := <-changes:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error wait: no change of the registry.")
+	}
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	b := bricker.New()
+	defer b.Done()
+	one, two := stack("CGy", 216), stack("6DbsDo", 13)
+	defer one.Done()
+	defer two.Done()
+	b.Attach(one, "one")
+	b.Attach(two, "two")
+	r := New(b)
+	defer r.Done()
+	changes := make(chan *Entry, 4)
+	if err := r.Watch("test", func(e *Entry) { changes <- e }); err != nil {
+		t.Fatalf("Error TestRegistry: could not watch (%s).", err)
+	}
+	if err := r.Watch("test", nil); err == nil {
+		t.Fatal("Error TestRegistry: watched twice with the same id.")
+	}
+	if err := r.EnumerateAll(); err != nil {
+		t.Fatalf("Error TestRegistry: could not enumerate (%s).", err)
+	}
+	wait(t, changes)
+	wait(t, changes)
+	if l := len(r.Devices()); l != 2 {
+		t.Fatalf("Error TestRegistry: registry should have 2 devices (%d).", l)
+	}
+	temp := r.DevicesOf(216)
+	if len(temp) != 1 || temp[0].Connector != "one" || temp[0].Uid() != 123456 {
+		t.Fatalf("Error TestRegistry: wrong temperature devices (%v).", temp)
+	}
+	if e := r.GetBase58("6DbsDo"); e == nil || e.Connector != "two" || e.Name() != "Brick Master" {
+		t.Fatalf("Error TestRegistry: wrong device for base58 uid (%s).", e)
+	}
+	uid := base58.Convert32(base58.DecodeString("6DbsDo"))
+	if n, ok := b.ConnectorOf(uid); !ok || n != "two" {
+		t.Fatalf("Error TestRegistry: uid not registered in the bricker (%s).", n)
+	}
+	two.Emit(callback("6DbsDo", 13, enumerate.EnumerationTypeDisconneted))
+	if e := wait(t, changes); e.Enumeration.EnumerationType != enumerate.EnumerationTypeDisconneted {
+		t.Fatalf("Error TestRegistry: change should be a disconnect (%s).", e)
+	}
+	if e := r.Get(uid); e != nil {
+		t.Fatalf("Error TestRegistry: disconnected device is still known (%s).", e)
+	}
+	if _, ok := b.ConnectorOf(uid); ok {
+		t.Fatal("Error TestRegistry: disconnected device is still registered in the bricker.")
+	}
+	if err := r.Unwatch("test"); err != nil {
+		t.Fatalf("Error TestRegistry: could not unwatch (%s).", err)
+	}
+}
+
+func TestTwoRegistries(t *testing.T) {
+	b := bricker.New()
+	defer b.Done()
+	one := stack("CGy", 216)
+	defer one.Done()
+	b.Attach(one, "one")
+	first, second := New(b), New(b)
+	defer first.Done()
+	defer second.Done()
+	changes := make(chan *Entry, 4)
+	first.Watch("test", func(e *Entry) { changes <- e })
+	second.Watch("test", func(e *Entry) { changes <- e })
+	if err := first.Enumerate("one"); err != nil {
+		t.Fatalf("Error TestTwoRegistries: could not enumerate the first registry (%s).", err)
+	}
+	wait(t, changes)
+	if err := second.Enumerate("one"); err != nil {
+		t.Fatalf("Error TestTwoRegistries: could not enumerate the second registry (%s).", err)
+	}
+	wait(t, changes)
+	wait(t, changes) // the enumeration callbacks go to both registries
+	if first.Get(123456) == nil || second.Get(123456) == nil {
+		t.Fatal("Error TestTwoRegistries: device should be known by both registries.")
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	b := bricker.New()
+	defer b.Done()
+	one := stack("CGy", 216)
+	defer one.Done()
+	b.Attach(one, "one")
+	r := New(b)
+	defer r.Done()
+	changes := make(chan *Entry, 8)
+	r.Watch("test", func(e *Entry) { changes <- e })
+	if err := r.Enumerate("one"); err != nil {
+		t.Fatalf("Error TestReconnect: could not enumerate (%s).", err)
+	}
+	wait(t, changes)
+	r.lock.Lock()
+	delete(r.entries, 123456) // the registry has lost the device
+	r.lock.Unlock()
+	one.Emit(event.NewState(event.StateReconnected, nil))
+	if e := wait(t, changes); e.Uid() != 123456 || e.Connector != "one" {
+		t.Fatalf("Error TestReconnect: wrong device after the reconnect (%s).", e)
+	}
+	if r.Get(123456) == nil {
+		t.Fatal("Error TestReconnect: device not enumerated again after the reconnect.")
+	}
+}
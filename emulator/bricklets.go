@@ -0,0 +1,178 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package emulator
+
+import (
+	"github.com/dirkjabl/bricker/net/payload"
+)
+
+// Names of the simulated values of the devices.
+const (
+	ValueStackVoltage      = "stackvoltage"    // Master, mV
+	ValueStackCurrent      = "stackcurrent"    // Master, mA
+	ValueUSBVoltage        = "usbvoltage"      // Master, mV
+	ValueTemperature       = "temperature"     // Temperature, °C/100
+	ValueIlluminance       = "illuminance"     // Ambient Light, Lux/10
+	ValueHumidity          = "humidity"        // Humidity, %RH/10
+	ValueVoltage           = "voltage"         // Analog In, mV
+	ValueAnalogValue       = "analogvalue"     // Ambient Light, Humidity, Analog In, 12bit
+	ValueAirPressure       = "airpressure"     // Barometer, mbar/1000
+	ValueAltitude          = "altitude"        // Barometer, cm
+	ValueChipTemperature   = "chiptemperature" // Barometer, °C/100
+	ValueMoisture          = "moisture"        // Moisture, 12bit
+	ValueTiltState         = "tiltstate"       // Tilt, 0 closed, 1 open, 2 closed vibrating
+	ValueMotion            = "motion"          // Motion Detector, 0 no motion, 1 motion
+	valueCallbackEnabled   = "callbackenabled" // Tilt, internal
+	deviceidMaster         = uint16(13)
+	deviceidAmbientLight   = uint16(21)
+	deviceidDualRelay      = uint16(26)
+	deviceidHumidity       = uint16(27)
+	deviceidTemperature    = uint16(216)
+	deviceidAnalogIn       = uint16(219)
+	deviceidAnalogOut      = uint16(220)
+	deviceidBarometer      = uint16(221)
+	deviceidMoisture       = uint16(232)
+	deviceidMotionDetector = uint16(233)
+	deviceidTilt           = uint16(239)
+)
+
+// Master creates a simulated master brick with the stack voltage and current and the USB voltage.
+func Master(uid string) *Device {
+	d := NewDevice(uid, deviceidMaster)
+	d.AddSensor(&Sensor{Name: ValueStackVoltage, Get: 1, SetPeriod: 47, GetPeriod: 48,
+		SetThreshold: 53, GetThreshold: 54, Callback: 60, Reached: 63})
+	d.AddSensor(&Sensor{Name: ValueStackCurrent, Get: 2, SetPeriod: 45, GetPeriod: 46,
+		SetThreshold: 51, GetThreshold: 52, Callback: 59, Reached: 62})
+	d.AddSensor(&Sensor{Name: ValueUSBVoltage, Get: 40, SetPeriod: 49, GetPeriod: 50,
+		SetThreshold: 55, GetThreshold: 56, Callback: 61, Reached: 64})
+	d.AddSensor(&Sensor{Name: ValueChipTemperature, Get: 242})
+	d.Debounce(57, 58)
+	return d
+}
+
+// Temperature creates a simulated temperature bricklet.
+func Temperature(uid string) *Device {
+	d := NewDevice(uid, deviceidTemperature)
+	d.AddSensor(&Sensor{Name: ValueTemperature, Get: 1, SetPeriod: 2, GetPeriod: 3,
+		SetThreshold: 4, GetThreshold: 5, Callback: 8, Reached: 9})
+	d.Debounce(6, 7)
+	d.Memory(10, 11, uint8(0)) // i2c mode
+	return d
+}
+
+// AmbientLight creates a simulated ambient light bricklet.
+func AmbientLight(uid string) *Device {
+	d := NewDevice(uid, deviceidAmbientLight)
+	d.AddSensor(&Sensor{Name: ValueIlluminance, Get: 1, SetPeriod: 3, GetPeriod: 4,
+		SetThreshold: 7, GetThreshold: 8, Callback: 13, Reached: 15})
+	d.AddSensor(&Sensor{Name: ValueAnalogValue, Get: 2, SetPeriod: 5, GetPeriod: 6,
+		SetThreshold: 9, GetThreshold: 10, Callback: 14, Reached: 16})
+	d.Debounce(11, 12)
+	return d
+}
+
+// Humidity creates a simulated humidity bricklet.
+func Humidity(uid string) *Device {
+	d := NewDevice(uid, deviceidHumidity)
+	d.AddSensor(&Sensor{Name: ValueHumidity, Get: 1, SetPeriod: 3, GetPeriod: 4,
+		SetThreshold: 7, GetThreshold: 8, Callback: 13, Reached: 15})
+	d.AddSensor(&Sensor{Name: ValueAnalogValue, Get: 2, SetPeriod: 5, GetPeriod: 6,
+		SetThreshold: 9, GetThreshold: 10, Callback: 14, Reached: 16})
+	d.Debounce(11, 12)
+	return d
+}
+
+// AnalogIn creates a simulated analog in bricklet.
+func AnalogIn(uid string) *Device {
+	d := NewDevice(uid, deviceidAnalogIn)
+	d.AddSensor(&Sensor{Name: ValueVoltage, Get: 1, SetPeriod: 3, GetPeriod: 4,
+		SetThreshold: 7, GetThreshold: 8, Callback: 13, Reached: 15})
+	d.AddSensor(&Sensor{Name: ValueAnalogValue, Get: 2, SetPeriod: 5, GetPeriod: 6,
+		SetThreshold: 9, GetThreshold: 10, Callback: 14, Reached: 16})
+	d.Debounce(11, 12)
+	d.Memory(17, 18, uint8(0))  // range
+	d.Memory(19, 20, uint8(50)) // averaging
+	return d
+}
+
+// AnalogOut creates a simulated analog out bricklet.
+func AnalogOut(uid string) *Device {
+	d := NewDevice(uid, deviceidAnalogOut)
+	d.Memory(1, 2, uint16(0)) // voltage
+	d.Memory(3, 4, uint8(1))  // mode
+	return d
+}
+
+// Barometer creates a simulated barometer bricklet.
+func Barometer(uid string) *Device {
+	d := NewDevice(uid, deviceidBarometer)
+	d.AddSensor(&Sensor{Name: ValueAirPressure, Wide: true, Get: 1, SetPeriod: 3, GetPeriod: 4,
+		SetThreshold: 7, GetThreshold: 8, Callback: 15, Reached: 17})
+	d.AddSensor(&Sensor{Name: ValueAltitude, Wide: true, Get: 2, SetPeriod: 5, GetPeriod: 6,
+		SetThreshold: 9, GetThreshold: 10, Callback: 16, Reached: 18})
+	d.AddSensor(&Sensor{Name: ValueChipTemperature, Get: 14})
+	d.Debounce(11, 12)
+	d.Memory(13, 19, int32(1013250))       // reference air pressure
+	d.Memory(20, 21, [3]uint8{25, 10, 10}) // averaging
+	return d
+}
+
+// Moisture creates a simulated moisture bricklet.
+func Moisture(uid string) *Device {
+	d := NewDevice(uid, deviceidMoisture)
+	d.AddSensor(&Sensor{Name: ValueMoisture, Get: 1, SetPeriod: 2, GetPeriod: 3,
+		SetThreshold: 4, GetThreshold: 5, Callback: 8, Reached: 9})
+	d.Debounce(6, 7)
+	d.Memory(10, 11, uint8(100)) // moving average
+	return d
+}
+
+// DualRelay creates a simulated dual relay bricklet.
+func DualRelay(uid string) *Device {
+	d := NewDevice(uid, deviceidDualRelay)
+	d.Memory(1, 2, [2]uint8{0, 0}) // state
+	return d
+}
+
+// Tilt creates a simulated tilt bricklet.
+// If the callback is enabled, a change of the tilt state sends the tilt state callback.
+func Tilt(uid string) *Device {
+	d := NewDevice(uid, deviceidTilt)
+	d.AddSensor(&Sensor{Name: ValueTiltState, Get: 1})
+	d.Handle(2, func(d *Device, request *payload.Payload) interface{} {
+		d.set(valueCallbackEnabled, 1)
+		return nil
+	})
+	d.Handle(3, func(d *Device, request *payload.Payload) interface{} {
+		d.set(valueCallbackEnabled, 0)
+		return nil
+	})
+	d.Handle(4, func(d *Device, request *payload.Payload) interface{} {
+		return uint8(d.values[valueCallbackEnabled])
+	})
+	d.Hook(ValueTiltState, func(d *Device, v int64) {
+		if d.values[valueCallbackEnabled] == 1 {
+			d.callback(5, uint8(v))
+		}
+	})
+	return d
+}
+
+// MotionDetector creates a simulated motion detector bricklet.
+// A motion sends the motion detected callback, the end of the motion sends the detection cycle ended callback.
+func MotionDetector(uid string) *Device {
+	d := NewDevice(uid, deviceidMotionDetector)
+	d.Handle(1, func(d *Device, request *payload.Payload) interface{} {
+		return uint8(d.values[ValueMotion])
+	})
+	d.Hook(ValueMotion, func(d *Device, v int64) {
+		if v == 1 {
+			d.callback(2, nil)
+		} else {
+			d.callback(3, nil)
+		}
+	})
+	return d
+}
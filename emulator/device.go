@@ -0,0 +1,226 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package emulator
+
+import (
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/identity"
+	"github.com/dirkjabl/bricker/net/base58"
+	"github.com/dirkjabl/bricker/net/errors"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/net/payload"
+	"sync"
+	"time"
+)
+
+// Tick is the resolution of the simulated callback periods and debounce periods.
+var Tick = 5 * time.Millisecond
+
+// Function is the implementation of a function of a simulated device.
+// It gets the payload of the request and returns the value for the response payload (or nil).
+// The function runs with the lock of the device, so it could use the values of the device direct.
+type Function func(d *Device, request *payload.Payload) interface{}
+
+// Device is a simulated brick or bricklet.
+// The values of the device are named, the functions, sensors and hooks work with this values.
+type Device struct {
+	lock      *sync.Mutex
+	identity  identity.Identity
+	functions map[uint8]Function
+	sensors   []*Sensor
+	values    map[string]int64
+	hooks     map[string]func(d *Device, v int64)
+	debounce  uint32
+	emulator  *Emulator
+	queue     []*packet.Packet
+	quit      chan struct{}
+}
+
+// NewDevice creates a simulated device with the base58 uid and the device identifer.
+func NewDevice(uid string, deviceidentifer uint16) *Device {
+	d := &Device{
+		lock:      new(sync.Mutex),
+		functions: make(map[uint8]Function),
+		sensors:   make([]*Sensor, 0),
+		values:    make(map[string]int64),
+		hooks:     make(map[string]func(*Device, int64)),
+		debounce:  100,
+		queue:     make([]*packet.Packet, 0)}
+	copy(d.identity.Uid[:], uid)
+	d.identity.ConnectedUid = [8]byte{'0'}
+	d.identity.Position = '0'
+	d.identity.HardwareVersion = [3]uint8{1, 0, 0}
+	d.identity.FirmwareVersion = [3]uint8{2, 0, 0}
+	d.identity.DeviceIdentifer = deviceidentifer
+	return d
+}
+
+// Uid returns the uid of the device as uint32.
+func (d *Device) Uid() uint32 {
+	return base58.Convert32(base58.Decode(d.identity.Uid))
+}
+
+// Identity returns the identity of the device.
+func (d *Device) Identity() identity.Identity {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.identity
+}
+
+// Handle sets the implementation of the function with the function id.
+func (d *Device) Handle(fid uint8, f Function) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.functions[fid] = f
+}
+
+// Memory adds a setter and a getter for a value (like a state of a relay).
+// The setter stores the request payload, the getter responds the stored payload.
+// The initial value is the given value.
+func (d *Device) Memory(set, get uint8, initial interface{}) {
+	stored := payload.NewPayloadEncode(initial)
+	d.Handle(set, func(d *Device, request *payload.Payload) interface{} {
+		stored = request.Copy()
+		return nil
+	})
+	d.Handle(get, func(d *Device, request *payload.Payload) interface{} {
+		return stored.Bytes()
+	})
+}
+
+// Debounce adds the setter and getter for the debounce period of the thresholds.
+func (d *Device) Debounce(set, get uint8) {
+	d.Handle(set, func(d *Device, request *payload.Payload) interface{} {
+		v := &device.Debounce{}
+		if request.Decode(v) == nil {
+			d.debounce = v.Value
+		}
+		return nil
+	})
+	d.Handle(get, func(d *Device, request *payload.Payload) interface{} {
+		return &device.Debounce{Value: d.debounce}
+	})
+}
+
+// Hook sets a function, which is called, if the named value changed.
+// The hook runs with the lock of the device.
+func (d *Device) Hook(name string, f func(d *Device, v int64)) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.hooks[name] = f
+}
+
+// Set changes the named value of the device (like the measured temperature).
+func (d *Device) Set(name string, v int64) {
+	d.lock.Lock()
+	d.set(name, v)
+	d.lock.Unlock()
+	d.flush()
+}
+
+// Value returns the named value of the device.
+func (d *Device) Value(name string) int64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.values[name]
+}
+
+// Callback sends a callback of the device with the value as payload to all clients.
+// Without a running emulator, the callback is dropped.
+func (d *Device) Callback(fid uint8, v interface{}) {
+	d.lock.Lock()
+	d.callback(fid, v)
+	d.lock.Unlock()
+	d.flush()
+}
+
+// Internal method: set changes the value and calls the hook.
+// The caller must hold the lock.
+func (d *Device) set(name string, v int64) {
+	d.values[name] = v
+	if h, ok := d.hooks[name]; ok {
+		h(d, v)
+	}
+}
+
+// Internal method: callback queues a callback, the callbacks are sent after the lock is released.
+// The caller must hold the lock.
+func (d *Device) callback(fid uint8, v interface{}) {
+	d.queue = append(d.queue, callback(d.Uid(), fid, v))
+}
+
+// Internal method: flush sends all queued callbacks.
+func (d *Device) flush() {
+	d.lock.Lock()
+	queue, e := d.queue, d.emulator
+	d.queue = make([]*packet.Packet, 0)
+	d.lock.Unlock()
+	if e == nil {
+		return
+	}
+	for _, p := range queue {
+		e.broadcast(p)
+	}
+}
+
+// Internal method: call runs the function of the request and returns the response.
+// The response is nil, if no response is expected.
+func (d *Device) call(request *packet.Packet) *packet.Packet {
+	var r *packet.Packet
+	d.lock.Lock()
+	if request.Head.FunctionID == function_get_identity {
+		r = response(request, &d.identity)
+	} else if f, ok := d.functions[request.Head.FunctionID]; ok {
+		r = response(request, f(d, request.Payload))
+	} else {
+		r = response(request, nil)
+		r.Head.ErrorCodeAndFutureUse = errors.ErrorFUNCTIONNOTSUPPORTED << 6
+	}
+	d.lock.Unlock()
+	d.flush()
+	if !request.Head.OptionResponseExpected() {
+		return nil
+	}
+	return r
+}
+
+// Internal method: start connects the device to the emulator and starts the simulation of the callbacks.
+func (d *Device) start(e *Emulator) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.emulator = e
+	d.quit = make(chan struct{})
+	go d.run(d.quit)
+}
+
+// Internal method: halt stops the simulation of the callbacks.
+func (d *Device) halt() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.quit != nil {
+		close(d.quit)
+		d.quit = nil
+	}
+	d.emulator = nil
+}
+
+// Internal method: run simulates the callback periods and thresholds of the sensors.
+func (d *Device) run(quit chan struct{}) {
+	ticker := time.NewTicker(Tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			d.lock.Lock()
+			for _, s := range d.sensors {
+				s.tick(d, now)
+			}
+			d.lock.Unlock()
+			d.flush()
+		case <-quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+An in-process brick daemon (brickd) emulator for testing.
+
+The emulator serves the TCP/IP protocol of the brick daemon on a local port.
+It hosts simulated stacks (a master brick with bricklets), answers the enumerate and
+get identity requests and the functions of the simulated devices.
+The simulated devices support getters and setters, callback periods, thresholds and debounce,
+so a application could be tested with a real connector without hardware.
+
+	emu, err := emulator.New("")
+	defer emu.Done()
+	temp := emulator.Temperature("temp")
+	emu.AddStack(emulator.Master("master"), temp)
+	conn, err := buffered.New(emu.Addr(), 10, 10)
+	...
+	temp.Set(emulator.ValueTemperature, 2150) // 21.5 °C
+*/
+package emulator
+
+import (
+	"crypto/rand"
+	"github.com/dirkjabl/bricker/device/enumerate"
+	brickernet "github.com/dirkjabl/bricker/net"
+	"github.com/dirkjabl/bricker/net/packet"
+	"net"
+	"sync"
+)
+
+// Function ids of the brick daemon and the broadcast enumerate.
+const (
+	function_get_authentication_nonce = uint8(1)
+	function_authenticate             = uint8(2)
+	function_enumerate                = uint8(254)
+	callback_enumerate                = uint8(253)
+	function_get_identity             = uint8(255)
+)
+
+// Emulator is the simulated brick daemon with his devices.
+type Emulator struct {
+	lock     *sync.RWMutex
+	stop     *sync.Once
+	listener net.Listener
+	secret   string
+	devices  []*Device
+	clients  map[*client]bool
+}
+
+// New creates a emulator, which listens on the given address (like "127.0.0.1:4223").
+// A empty address means a free port on the local host.
+func New(addr string) (*Emulator, error) {
+	return NewAuthenticated(addr, "")
+}
+
+// NewAuthenticated creates a emulator, which needs the authentication with the secret.
+// Until a client is authenticated, the emulator only answers the authentication functions.
+// A wrong secret closes the connection, like the brick daemon does.
+func NewAuthenticated(addr, secret string) (*Emulator, error) {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	e := &Emulator{
+		lock:     new(sync.RWMutex),
+		stop:     new(sync.Once),
+		listener: l,
+		secret:   secret,
+		devices:  make([]*Device, 0),
+		clients:  make(map[*client]bool)}
+	go e.accept()
+	return e, nil
+}
+
+// Addr returns the address of the emulator, usable for the connectors.
+func (e *Emulator) Addr() string {
+	return e.listener.Addr().String()
+}
+
+// Done closes all connections and stops all devices.
+func (e *Emulator) Done() {
+	e.stop.Do(func() {
+		e.listener.Close()
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		for c := range e.clients {
+			c.conn.Close()
+			delete(e.clients, c)
+		}
+		for _, d := range e.devices {
+			d.halt()
+		}
+		e.devices = e.devices[:0]
+	})
+}
+
+// Add connects a device to the emulator.
+// All clients get a enumerate callback for the newly connected device.
+func (e *Emulator) Add(d *Device) {
+	e.lock.Lock()
+	e.devices = append(e.devices, d)
+	e.lock.Unlock()
+	d.start(e)
+	e.broadcast(enumeration(d, enumerate.EnumerationTypeNewlyConnected))
+}
+
+// AddStack connects a master brick and his bricklets to the emulator.
+// The bricklets get the positions 'a' to 'd' at the master.
+func (e *Emulator) AddStack(master *Device, bricklets ...*Device) {
+	master.identity.ConnectedUid = [8]byte{'0'}
+	master.identity.Position = '0'
+	e.Add(master)
+	for i, b := range bricklets {
+		b.identity.ConnectedUid = master.identity.Uid
+		b.identity.Position = byte('a' + i)
+		e.Add(b)
+	}
+}
+
+// Remove disconnects the device with the uid from the emulator.
+// All clients get a enumerate callback for the disconnected device.
+func (e *Emulator) Remove(uid uint32) {
+	e.lock.Lock()
+	var d *Device
+	for i, v := range e.devices {
+		if v.Uid() == uid {
+			d = v
+			e.devices = append(e.devices[:i], e.devices[i+1:]...)
+			break
+		}
+	}
+	e.lock.Unlock()
+	if d != nil {
+		d.halt()
+		e.broadcast(enumeration(d, enumerate.EnumerationTypeDisconneted))
+	}
+}
+
+// Device returns the device with the uid or nil, if the emulator has no such device.
+func (e *Emulator) Device(uid uint32) *Device {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	for _, d := range e.devices {
+		if d.Uid() == uid {
+			return d
+		}
+	}
+	return nil
+}
+
+// Disconnect closes the connections of all clients (like a lost connection).
+// The emulator accepts new connections.
+func (e *Emulator) Disconnect() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for c := range e.clients {
+		c.conn.Close()
+		delete(e.clients, c)
+	}
+}
+
+// Internal method: accept waits for new clients.
+func (e *Emulator) accept() {
+	for {
+		conn, err := e.listener.Accept()
+		if err != nil {
+			return // listener closed
+		}
+		c := &client{conn: conn, wlock: new(sync.Mutex), authenticated: e.secret == ""}
+		e.lock.Lock()
+		e.clients[c] = true
+		e.lock.Unlock()
+		go e.serve(c)
+	}
+}
+
+// Internal method: serve reads the requests of a client and answers them.
+func (e *Emulator) serve(c *client) {
+	defer e.drop(c)
+	var nonce brickernet.Nonce
+	rand.Read(nonce[:])
+	for {
+		p, err := packet.ReadNew(c.conn)
+		if err != nil && p == nil {
+			return // connection closed
+		}
+		switch {
+		case p.Head.Uid == brickernet.AuthenticationUid && p.Head.FunctionID == function_get_authentication_nonce:
+			c.write(response(p, nonce))
+		case p.Head.Uid == brickernet.AuthenticationUid && p.Head.FunctionID == function_authenticate:
+			a := &brickernet.Authenticate{}
+			if p.Payload.Decode(a) != nil || a.Digest != brickernet.Digest(e.secret, nonce, a.ClientNonce) {
+				return // wrong secret, disconnect the client
+			}
+			c.authenticate()
+			c.write(response(p, nil))
+		case !c.isAuthenticated(): // drop all requests without authentication
+		case p.Head.Uid == 0 && p.Head.FunctionID == function_enumerate:
+			e.lock.RLock()
+			devices := append([]*Device{}, e.devices...)
+			e.lock.RUnlock()
+			for _, d := range devices {
+				c.write(enumeration(d, enumerate.EnumerationTypeAvailable))
+			}
+		default:
+			if d := e.Device(p.Head.Uid); d != nil {
+				if r := d.call(p); r != nil {
+					c.write(r)
+				}
+			}
+		}
+	}
+}
+
+// Internal method: drop removes the client.
+func (e *Emulator) drop(c *client) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	c.conn.Close()
+	delete(e.clients, c)
+}
+
+// Internal method: broadcast sends the packet (a callback) to all authenticated clients.
+func (e *Emulator) broadcast(p *packet.Packet) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	for c := range e.clients {
+		if c.isAuthenticated() {
+			c.write(p)
+		}
+	}
+}
+
+// Internal type: client is a connection to the emulator.
+type client struct {
+	conn          net.Conn
+	wlock         *sync.Mutex
+	authenticated bool
+}
+
+// Internal method: authenticate marks the client as authenticated.
+func (c *client) authenticate() {
+	c.wlock.Lock()
+	defer c.wlock.Unlock()
+	c.authenticated = true
+}
+
+// Internal method: isAuthenticated checks, if the client could get packets.
+func (c *client) isAuthenticated() bool {
+	c.wlock.Lock()
+	defer c.wlock.Unlock()
+	return c.authenticated
+}
+
+// Internal method: write sends the packet to the client.
+func (c *client) write(p *packet.Packet) {
+	c.wlock.Lock()
+	defer c.wlock.Unlock()
+	p.Head.Length = p.ComputeLength()
+	p.Write(c.conn)
+}
+
+// Internal function: enumeration creates the enumerate callback of the device.
+func enumeration(d *Device, enumerationtype uint8) *packet.Packet {
+	en := &enumerate.Enumeration{Identity: d.Identity(), EnumerationType: enumerationtype}
+	return callback(d.Uid(), callback_enumerate, en)
+}
+
+// Internal function: callback creates a callback packet (without sequence) with the value as payload.
+func callback(uid uint32, fid uint8, v interface{}) *packet.Packet {
+	if v == nil {
+		return packet.NewSimpleHeaderOnly(uid, fid, false)
+	}
+	return packet.NewSimpleHeaderPayload(uid, fid, false, v)
+}
+
+// Internal function: response creates the response for the request with the value as payload.
+func response(request *packet.Packet, v interface{}) *packet.Packet {
+	r := callback(request.Head.Uid, request.Head.FunctionID, v)
+	r.Head.SequenceAndOptions = request.Head.SequenceAndOptions
+	return r
+}
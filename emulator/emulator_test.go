@@ -0,0 +1,160 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package emulator
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/buffered"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/bricklet/temperature"
+	"github.com/dirkjabl/bricker/device/identity"
+	"github.com/dirkjabl/bricker/device/registry"
+	"testing"
+	"time"
+)
+
+// connect creates a emulator with a stack (master and temperature bricklet) and a bricker connected to it.
+func connect(t *testing.T) (*Emulator, *Device, *bricker.Bricker, func()) {
+	emu, err := New("")
+	if err != nil {
+		t.Fatalf("Error connect: could not start emulator (%s).", err)
+	}
+	temp := Temperature("temp")
+	emu.AddStack(Master("master"), temp)
+	conn, err := buffered.New(emu.Addr(), 10, 10)
+	if err != nil {
+		emu.Done()
+		t.Fatalf("Error connect: could not connect to the emulator (%s).", err)
+	}
+	brick := bricker.New()
+	brick.Attach(conn, "emulator")
+	return emu, temp, brick, func() {
+		brick.Done()
+		conn.Done()
+		emu.Done()
+	}
+}
+
+// temperatures collects the values of the temperature callbacks.
+func temperatures(values chan int16) func(device.Resulter, error) {
+	return func(r device.Resulter, err error) {
+		if v, ok := r.(*temperature.Temperature); ok && err == nil {
+			values <- v.Value
+		}
+	}
+}
+
+// next waits for the next value or fails after a timeout.
+func next(t *testing.T, values chan int16) int16 {
+	select {
+	case v := <-values:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error next: no callback from the emulator.")
+	}
+	return 0
+}
+
+func TestGetterSetter(t *testing.T) {
+	_, temp, brick, done := connect(t)
+	defer done()
+	temp.Set(ValueTemperature, -150)
+	v, err := temperature.GetTemperatureFuture(brick, "emulator", temp.Uid())
+	if err != nil || v.Value != -150 {
+		t.Fatalf("Error TestGetterSetter: wrong temperature (%v, %v).", v, err)
+	}
+	if err = temperature.SetI2CModeFuture(brick, "emulator", temp.Uid(), &temperature.I2CMode{Value: 1}); err != nil {
+		t.Fatalf("Error TestGetterSetter: could not set the i2c mode (%s).", err)
+	}
+	if m, err := temperature.GetI2CModeFuture(brick, "emulator", temp.Uid()); err != nil || m.Value != 1 {
+		t.Fatalf("Error TestGetterSetter: wrong i2c mode (%v, %v).", m, err)
+	}
+	i, err := identity.GetIdentityFuture(brick, "emulator", temp.Uid())
+	if err != nil || !i.Is(deviceidTemperature) || i.Position != 'a' {
+		t.Fatalf("Error TestGetterSetter: wrong identity (%v, %v).", i, err)
+	}
+}
+
+func TestCallbackPeriod(t *testing.T) {
+	_, temp, brick, done := connect(t)
+	defer done()
+	temp.Set(ValueTemperature, 2150)
+	values := make(chan int16, 10)
+	brick.Subscribe(temperature.TemperaturePeriod("period", temp.Uid(), temperatures(values)), "emulator")
+	if err := temperature.SetTemperatureCallbackPeriodFuture(brick, "emulator", temp.Uid(), &device.Period{Value: 10}); err != nil {
+		t.Fatalf("Error TestCallbackPeriod: could not set the callback period (%s).", err)
+	}
+	if v := next(t, values); v != 2150 {
+		t.Fatalf("Error TestCallbackPeriod: wrong temperature (%d).", v)
+	}
+	temp.Set(ValueTemperature, 2200)
+	if v := next(t, values); v != 2200 {
+		t.Fatalf("Error TestCallbackPeriod: only changed values should be sent (%d).", v)
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	_, temp, brick, done := connect(t)
+	defer done()
+	values := make(chan int16, 10)
+	brick.Subscribe(temperature.TemperatureReached("reached", temp.Uid(), temperatures(values)), "emulator")
+	temperature.SetDebouncePeriodFuture(brick, "emulator", temp.Uid(), &device.Debounce{Value: 10})
+	th := &device.Threshold16{Option: device.ThresholdBiggerMin, Min: 3000}
+	if err := temperature.SetTemperatureCallbackThresholdFuture(brick, "emulator", temp.Uid(), th); err != nil {
+		t.Fatalf("Error TestThreshold: could not set the threshold (%s).", err)
+	}
+	if r, err := temperature.GetTemperatureCallbackThresholdFuture(brick, "emulator", temp.Uid()); err != nil || *r != *th {
+		t.Fatalf("Error TestThreshold: wrong threshold (%v, %v).", r, err)
+	}
+	temp.Set(ValueTemperature, 3100)
+	if v := next(t, values); v != 3100 {
+		t.Fatalf("Error TestThreshold: wrong temperature (%d).", v)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	emu, temp, brick, done := connect(t)
+	defer done()
+	r := registry.New(brick)
+	defer r.Done()
+	changes := make(chan *registry.Entry, 10)
+	r.Watch("test", func(e *registry.Entry) { changes <- e })
+	r.EnumerateAll()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-changes:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Error TestEnumerate: no enumeration.")
+		}
+	}
+	if l := len(r.Devices()); l != 2 {
+		t.Fatalf("Error TestEnumerate: wrong number of devices (%d).", l)
+	}
+	emu.Remove(temp.Uid())
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestEnumerate: no enumeration for the removed device.")
+	}
+	if e := r.Get(temp.Uid()); e != nil {
+		t.Fatalf("Error TestEnumerate: removed device is still known (%s).", e)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	emu, err := NewAuthenticated("", "secret")
+	if err != nil {
+		t.Fatalf("Error TestAuthentication: could not start emulator (%s).", err)
+	}
+	defer emu.Done()
+	if _, err = buffered.NewAuthenticated(emu.Addr(), "wrong", 0, 0, nil); err == nil {
+		t.Fatal("Error TestAuthentication: connected with a wrong secret.")
+	}
+	conn, err := buffered.NewAuthenticated(emu.Addr(), "secret", 0, 0, nil)
+	if err != nil {
+		t.Fatalf("Error TestAuthentication: could not connect (%s).", err)
+	}
+	conn.Done()
+}
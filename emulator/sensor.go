@@ -0,0 +1,126 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package emulator
+
+import (
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/net/payload"
+	"time"
+)
+
+// Sensor is a simulated measured value of a device with the typical functions of the bricklets:
+// the getter, the callback period with the value callback and the threshold with the reached callback.
+// The function ids of the sensor are the ids of the real bricklet, a zero id means the function is not supported.
+type Sensor struct {
+	Name         string // name of the value of the device
+	Wide         bool   // 32bit value, otherwise 16bit
+	Get          uint8  // getter of the value
+	SetPeriod    uint8  // setter of the callback period
+	GetPeriod    uint8  // getter of the callback period
+	Callback     uint8  // callback with the value
+	SetThreshold uint8  // setter of the threshold
+	GetThreshold uint8  // getter of the threshold
+	Reached      uint8  // callback, if the threshold is reached
+	period       uint32
+	threshold    device.Threshold32
+	last         int64
+	sent         bool
+	nextperiod   time.Time
+	nextreached  time.Time
+}
+
+// AddSensor adds the sensor and his functions to the device.
+func (d *Device) AddSensor(s *Sensor) {
+	s.threshold.Option = device.ThresholdTurnedOff
+	d.lock.Lock()
+	d.sensors = append(d.sensors, s)
+	d.lock.Unlock()
+	if s.Get != 0 {
+		d.Handle(s.Get, func(d *Device, request *payload.Payload) interface{} {
+			return s.encode(d.values[s.Name])
+		})
+	}
+	if s.SetPeriod != 0 {
+		d.Handle(s.SetPeriod, func(d *Device, request *payload.Payload) interface{} {
+			p := &device.Period{}
+			if request.Decode(p) == nil {
+				s.period = p.Value
+				s.nextperiod = time.Now()
+			}
+			return nil
+		})
+	}
+	if s.GetPeriod != 0 {
+		d.Handle(s.GetPeriod, func(d *Device, request *payload.Payload) interface{} {
+			return &device.Period{Value: s.period}
+		})
+	}
+	if s.SetThreshold != 0 {
+		d.Handle(s.SetThreshold, func(d *Device, request *payload.Payload) interface{} {
+			if s.Wide {
+				request.Decode(&s.threshold)
+			} else {
+				t := &device.Threshold16{}
+				if request.Decode(t) == nil {
+					s.threshold = device.Threshold32{Option: t.Option, Min: int32(t.Min), Max: int32(t.Max)}
+				}
+			}
+			s.nextreached = time.Now()
+			return nil
+		})
+	}
+	if s.GetThreshold != 0 {
+		d.Handle(s.GetThreshold, func(d *Device, request *payload.Payload) interface{} {
+			if s.Wide {
+				return &s.threshold
+			}
+			return &device.Threshold16{Option: s.threshold.Option,
+				Min: int16(s.threshold.Min), Max: int16(s.threshold.Max)}
+		})
+	}
+}
+
+// Internal method: encode converts the value to the payload value of the sensor.
+func (s *Sensor) encode(v int64) interface{} {
+	if s.Wide {
+		return int32(v)
+	}
+	return uint16(v)
+}
+
+// Internal method: reached checks the value against the threshold.
+func (s *Sensor) reached(v int64) bool {
+	min, max := int64(s.threshold.Min), int64(s.threshold.Max)
+	switch s.threshold.Option {
+	case device.ThresholdOutside:
+		return v < min || v > max
+	case device.ThresholdInside:
+		return v >= min && v <= max
+	case device.ThresholdSmallerMin:
+		return v < min
+	case device.ThresholdBiggerMin:
+		return v > min
+	}
+	return false
+}
+
+// Internal method: tick queues the callbacks of the sensor, which are due.
+// A value callback is only sent, if the value changed since the last callback.
+// A reached callback is sent again after the debounce period, if the threshold is still reached.
+// The caller must hold the lock of the device.
+func (s *Sensor) tick(d *Device, now time.Time) {
+	v := d.values[s.Name]
+	if s.Callback != 0 && s.period > 0 && !now.Before(s.nextperiod) {
+		s.nextperiod = now.Add(time.Duration(s.period) * time.Millisecond)
+		if !s.sent || v != s.last {
+			s.sent, s.last = true, v
+			d.callback(s.Callback, s.encode(v))
+		}
+	}
+	if s.Reached != 0 && !now.Before(s.nextreached) && s.reached(v) {
+		s.nextreached = now.Add(time.Duration(d.debounce) * time.Millisecond)
+		d.callback(s.Reached, s.encode(v))
+	}
+}
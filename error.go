@@ -12,6 +12,10 @@ const (
 	ErrorConnectorNameExists
 	ErrorConnectorNameNotExists
 	ErrorNoConnectorToRelease
+	ErrorTimeout
+	ErrorCanceled
+	ErrorNoPacket
+	ErrorDisconnected
 )
 
 // Error type for bricker.
@@ -37,9 +41,22 @@ func (e Error) Error() string {
 		return "No connector with this name could be released."
 	case ErrorNoSubscriberToRelease:
 		return "No subscriber with this subscription could be released."
+	case ErrorTimeout:
+		return "Timeout while waiting for the response."
+	case ErrorCanceled:
+		return "Call was canceled before the response comes in."
+	case ErrorNoPacket:
+		return "Response without a packet."
+	case ErrorDisconnected:
+		return "Connection to the brick daemon is lost."
 	case ErrorUnknown:
 		fallthrough
 	default:
 		return "Unknown error."
 	}
 }
+
+// Timeout reports, if the error is a timeout error.
+func (e Error) Timeout() bool {
+	return e.Code == ErrorTimeout
+}
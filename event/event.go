@@ -5,6 +5,7 @@
 /*
 This package implements the event type.
 The event holds the packet, error, timestamp and a name of the connector.
+A connector could also create state events for the connection, they have no packet but a state.
 
 An error is only set, if an error occured.
 The packet could be a nil pointer.
@@ -21,14 +22,24 @@ import (
 	"time"
 )
 
+// Connection states of a state event.
+const (
+	StateNone         = uint8(0) // no state event
+	StateConnected    = uint8(1) // connection is established
+	StateDisconnected = uint8(2) // connection is lost
+	StateReconnected  = uint8(3) // connection is established again after a lost
+)
+
 // Event for the bricker.
 //
 // Save the packet, error (if occured) and a timestamp.
+// A state event saves the connection state instead of a packet.
 type Event struct {
 	Err           error
 	TimeStamp     time.Time
 	Packet        *packet.Packet
 	ConnectorName string
+	State         uint8
 }
 
 // NewEvent creates an event with all content.
@@ -54,12 +65,42 @@ func NewError(e error) *Event {
 	return New(e, time.Now(), nil)
 }
 
+// NewState creates a state event for the connection with a given state and an error (if occured).
+// The timestamp will be created and the packet is set to nil.
+func NewState(state uint8, e error) *Event {
+	ev := New(e, time.Now(), nil)
+	ev.State = state
+	return ev
+}
+
 // Copy makes a real deep copy of the event.
 func (e *Event) Copy() *Event {
 	if e == nil { // no event, no copy
 		return nil
 	}
-	return &Event{Err: e.Err, TimeStamp: e.TimeStamp, Packet: e.Packet.Copy(), ConnectorName: e.ConnectorName}
+	return &Event{Err: e.Err, TimeStamp: e.TimeStamp, Packet: e.Packet.Copy(),
+		ConnectorName: e.ConnectorName, State: e.State}
+}
+
+// IsState checks, if the event is a state event of a connection.
+func (e *Event) IsState() bool {
+	return e != nil && e.State != StateNone
+}
+
+// StateName gives a string representation of the connection state.
+func StateName(state uint8) string {
+	switch state {
+	case StateNone:
+		return "None"
+	case StateConnected:
+		return "Connected"
+	case StateDisconnected:
+		return "Disconnected"
+	case StateReconnected:
+		return "Reconnected"
+	default:
+		return "Unknown"
+	}
 }
 
 // String fullfill the stringer interface.
@@ -72,6 +113,9 @@ func (e *Event) String() string {
 			txt += "ConnectorName: " + e.ConnectorName + ", "
 		}
 		txt += "TimeStamp:" + e.TimeStamp.Format(time.RFC3339Nano) + ", "
+		if e.IsState() {
+			txt += "State: " + StateName(e.State) + ", "
+		}
 		txt += fmt.Sprintf("%v, ", e.Packet)
 		if e.Err != nil {
 			txt += "Error: " + e.Err.Error()
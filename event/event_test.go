@@ -103,3 +103,19 @@ func TestString(t *testing.T) {
 			ev.String(), strings.Index(ev.String(), "Error: Error"))
 	}
 }
+
+func TestNewState(t *testing.T) {
+	ev := NewState(StateReconnected, nil)
+	if !ev.IsState() || ev.Packet != nil {
+		t.Fatalf("Error TestNewState: no state event (%v).", ev)
+	}
+	if ev.Copy().State != StateReconnected {
+		t.Fatalf("Error TestNewState: copy lost the state (%v).", ev.Copy())
+	}
+	if strings.Index(ev.String(), "State: Reconnected") < 0 {
+		t.Fatalf("Error TestNewState: String result not correct: %s", ev.String())
+	}
+	if NewError(nil).IsState() {
+		t.Fatal("Error TestNewState: error event is a state event.")
+	}
+}
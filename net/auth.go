@@ -0,0 +1,96 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	goerrors "errors"
+	"github.com/dirkjabl/bricker/net/errors"
+	"github.com/dirkjabl/bricker/net/packet"
+	"io"
+	"syscall"
+	"time"
+)
+
+// Authentication of the brick daemon (brickd), the functions use the uid of the brick daemon.
+const (
+	AuthenticationUid                 = uint32(1)
+	function_get_authentication_nonce = uint8(1)
+	function_authenticate             = uint8(2)
+)
+
+// AuthenticationTimeout is the maximal time for the authentication handshake.
+var AuthenticationTimeout = 2500 * time.Millisecond
+
+// Nonce of the server or the client.
+type Nonce [4]uint8
+
+// Authenticate is the request for the authentication with the nonce of the client
+// and the HMAC-SHA1 digest over the server nonce and the client nonce.
+type Authenticate struct {
+	ClientNonce Nonce
+	Digest      [20]uint8
+}
+
+// Digest computes the HMAC-SHA1 digest with the secret over the server nonce and the client nonce.
+func Digest(secret string, server, client Nonce) [20]uint8 {
+	var d [20]uint8
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(server[:])
+	mac.Write(client[:])
+	copy(d[:], mac.Sum(nil))
+	return d
+}
+
+// Authenticate runs the authentication handshake with the brick daemon over the connection.
+// It gets the server nonce and sends the client nonce with the digest.
+// The brick daemon closes the connection, if the secret is wrong, this results in an authentication error.
+// All other errors (like a timeout) are returned unchanged.
+func (c *Net) Authenticate(secret string) error {
+	c.Conn.SetDeadline(time.Now().Add(AuthenticationTimeout))
+	defer c.Conn.SetDeadline(time.Time{})
+	pck, err := c.call(packet.NewSimpleHeaderOnly(AuthenticationUid, function_get_authentication_nonce, true))
+	if err != nil {
+		return err
+	}
+	var server, client Nonce
+	if err = pck.Payload.Decode(&server); err != nil {
+		return err
+	}
+	if _, err = rand.Read(client[:]); err != nil {
+		return err
+	}
+	a := &Authenticate{ClientNonce: client, Digest: Digest(secret, server, client)}
+	_, err = c.call(packet.NewSimpleHeaderPayload(AuthenticationUid, function_authenticate, true, a))
+	if closed(err) { // connection closed by the brick daemon
+		err = errors.New(errors.ErrorAuthenticationFailed)
+	}
+	return err
+}
+
+// Internal function: closed checks, if the error comes from a connection closed by the other side.
+func closed(err error) bool {
+	return goerrors.Is(err, io.EOF) || goerrors.Is(err, io.ErrUnexpectedEOF) || goerrors.Is(err, syscall.ECONNRESET)
+}
+
+// Internal method: call writes the packet and reads the response of the brick daemon.
+// Packets from other devices are skipped.
+func (c *Net) call(p *packet.Packet) (*packet.Packet, error) {
+	p.Head.SetSequence(1)
+	if err := c.WritePacket(p); err != nil {
+		return nil, err
+	}
+	for {
+		r, err := c.ReadPacket()
+		if r != nil && r.Head.Uid == p.Head.Uid && r.Head.FunctionID == p.Head.FunctionID {
+			return r, err
+		}
+		if err != nil && r == nil {
+			return nil, err
+		}
+	}
+}
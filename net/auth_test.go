@@ -0,0 +1,117 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"github.com/dirkjabl/bricker/net/errors"
+	"github.com/dirkjabl/bricker/net/packet"
+	"net"
+	"testing"
+	"time"
+)
+
+// brickd simulates the authentication of a brick daemon with the secret.
+// It counts the successful authentications.
+func brickd(t *testing.T, secret string) (net.Listener, chan bool) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error brickd: could not listen (%s).", err)
+	}
+	result := make(chan bool, 4)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				server := Nonce{1, 2, 3, 4}
+				p, err := packet.ReadNew(c)
+				if err != nil || p.Head.FunctionID != function_get_authentication_nonce {
+					return
+				}
+				r := packet.NewSimpleHeaderPayload(AuthenticationUid, function_get_authentication_nonce, true, server)
+				r.Head.SetSequence(p.Head.Sequence())
+				r.Write(c)
+				if p, err = packet.ReadNew(c); err != nil || p.Head.FunctionID != function_authenticate {
+					return
+				}
+				a := &Authenticate{}
+				p.Payload.Decode(a)
+				if a.Digest != Digest(secret, server, a.ClientNonce) {
+					result <- false
+					return // like the brick daemon, disconnect the client
+				}
+				r = packet.NewSimpleHeaderOnly(AuthenticationUid, function_authenticate, true)
+				r.Head.SetSequence(p.Head.Sequence())
+				r.Write(c)
+				result <- true
+				packet.ReadNew(c) // wait until the client closes
+			}(c)
+		}
+	}()
+	return l, result
+}
+
+func TestAuthenticate(t *testing.T) {
+	l, result := brickd(t, "secret")
+	defer l.Close()
+	c, err := DialAuthenticated(l.Addr().String(), "secret")
+	if err != nil {
+		t.Fatalf("Error TestAuthenticate: authentication failed (%s).", err)
+	}
+	defer c.Close()
+	if !<-result {
+		t.Fatal("Error TestAuthenticate: brickd does not accept the digest.")
+	}
+	if err = c.Redial(); err != nil {
+		t.Fatalf("Error TestAuthenticate: authentication after redial failed (%s).", err)
+	}
+	if !<-result {
+		t.Fatal("Error TestAuthenticate: brickd does not accept the digest after redial.")
+	}
+}
+
+func TestAuthenticateWrongSecret(t *testing.T) {
+	l, _ := brickd(t, "secret")
+	defer l.Close()
+	_, err := DialAuthenticated(l.Addr().String(), "wrong")
+	if e, ok := err.(*errors.Error); !ok || e.Type != errors.ErrorAuthenticationFailed {
+		t.Fatalf("Error TestAuthenticateWrongSecret: should fail with an authentication error (%v).", err)
+	}
+}
+
+func TestAuthenticateTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error TestAuthenticateTimeout: could not listen (%s).", err)
+	}
+	defer l.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() { // answers the nonce, but never the authentication
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		p, err := packet.ReadNew(c)
+		if err != nil {
+			return
+		}
+		r := packet.NewSimpleHeaderPayload(AuthenticationUid, function_get_authentication_nonce, true, Nonce{1, 2, 3, 4})
+		r.Head.SetSequence(p.Head.Sequence())
+		r.Write(c)
+		<-done
+	}()
+	timeout := AuthenticationTimeout
+	AuthenticationTimeout = 100 * time.Millisecond
+	defer func() { AuthenticationTimeout = timeout }()
+	_, err = DialAuthenticated(l.Addr().String(), "secret")
+	if e, ok := err.(net.Error); !ok || !e.Timeout() {
+		t.Fatalf("Error TestAuthenticateTimeout: should fail with a timeout (%v).", err)
+	}
+}
@@ -37,6 +37,13 @@ func Decode(src [8]byte) uint64 {
 	return value
 }
 
+// DecodeString converts a base58 string (maximal 8 characters) to a uint64 number.
+func DecodeString(src string) uint64 {
+	var dest [8]byte
+	copy(dest[:], src)
+	return Decode(dest)
+}
+
 // Encode converts a uint64 number to a 8 byte base58 representation (c style string).
 func Encode(src uint64) [8]byte {
 	var (
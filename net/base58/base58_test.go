@@ -5,6 +5,7 @@
 package base58
 
 import (
+	"bytes"
 	"testing"
 )
 
@@ -54,3 +55,12 @@ func TestConvert32(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeString(t *testing.T) {
+	for k, v := range testset {
+		s := string(bytes.TrimRight(v[:], "\x00"))
+		if dest := DecodeString(s); dest != k {
+			t.Fatalf("Error TestDecodeString: %s, decode to uint64: %d - has to %d ", s, dest, k)
+		}
+	}
+}
@@ -16,6 +16,7 @@ const (
 	ErrorFUNCTIONNOTSUPPORTED
 	ErrorUNKNOWN
 	ErrorHeaderMissing
+	ErrorAuthenticationFailed
 )
 
 /*
@@ -42,6 +43,8 @@ func (e *Error) Error() string {
 		return "Function not supported"
 	case ErrorHeaderMissing:
 		return "No header for packet, header needed."
+	case ErrorAuthenticationFailed:
+		return "Authentication failed, the secret is wrong."
 	case ErrorUNKNOWN:
 		fallthrough
 	default:
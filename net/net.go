@@ -14,20 +14,30 @@ import (
 
 // IPConn holds the connection and the address for that connection and has methods to read and write packets.
 // No locks or anything to make it thread save. This is the raw structure for communication.
+// With a secret, every dial authenticates the connection at the brick daemon.
 type Net struct {
 	Address string
+	Secret  string
 	Conn    *net.TCPConn
 }
 
 // Dial is a shortcut to IPConn.Dial.
 func Dial(addr string) (*Net, error) {
+	return DialAuthenticated(addr, "")
+}
+
+// DialAuthenticated is a shortcut to IPConn.Dial with a secret for the authentication.
+// A empty secret means no authentication.
+func DialAuthenticated(addr, secret string) (*Net, error) {
 	conn := new(Net)
 	conn.Address = addr
+	conn.Secret = secret
 	err := conn.Dial()
 	return conn, err
 }
 
 // Dial makes a connection to the given IPConn object.
+// If the object has a secret, the connection will be authenticated.
 func (c *Net) Dial() error {
 	var conn *net.TCPConn
 
@@ -40,6 +50,12 @@ func (c *Net) Dial() error {
 		return err
 	}
 	c.Conn = conn
+	if c.Secret != "" {
+		if err = c.Authenticate(c.Secret); err != nil {
+			c.Close()
+			return err
+		}
+	}
 	return nil
 }
 
@@ -54,7 +70,15 @@ func (c *Net) ReadPacket() (*packet.Packet, error) {
 	return p, err
 }
 
+// Redial closes the actual connection and makes a new connection to the address.
+func (c *Net) Redial() error {
+	c.Close()
+	return c.Dial()
+}
+
 // Close disconnected the connection.
 func (c *Net) Close() {
-	c.Conn.Close()
+	if c.Conn != nil {
+		c.Conn.Close()
+	}
 }
@@ -35,9 +35,7 @@ func (o *OptionalData) Copy() *OptionalData {
 	if o == nil { // no optionaldata, no copy
 		return nil
 	}
-	n := &OptionalData{}
-	copy(*o, *n)
-	return n
+	return New(*o)
 }
 
 // Write writes the optinal data into a given writer.
@@ -36,6 +36,9 @@ func New(h *head.Head, p *payload.Payload, o *optionaldata.OptionalData) *Packet
 
 // Copy makes a real deep copy of the packet.
 func (p *Packet) Copy() *Packet {
+	if p == nil { // no packet, no copy
+		return nil
+	}
 	n := &Packet{}
 	n.Head = p.Head.Copy()
 	n.Payload = p.Payload.Copy()
@@ -123,12 +126,15 @@ func (p *Packet) String() string {
 	return fmt.Sprintf("[%v, %v, %v]", p.Head, p.Payload, p.OptionalData)
 }
 
-// ReadPacket simplify the read from a io.Reader, it creates the new packet
+// ReadPacket simplify the read from a io.Reader, it creates the new packet.
+// If the packet is read complete, but the header has an error code, the packet and the error is the result.
 func ReadNew(r io.Reader) (*Packet, error) {
 	p := &Packet{}
 	err := p.Read(r)
 	if err != nil {
-		p = nil // delete packet, if a error occur
+		if _, ok := err.(*errors.Error); !ok {
+			p = nil // delete packet, if a error occur
+		}
 	}
 	return p, err
 }
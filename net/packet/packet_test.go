@@ -5,6 +5,8 @@
 package packet
 
 import (
+	"bytes"
+	"github.com/dirkjabl/bricker/net/errors"
 	"github.com/dirkjabl/bricker/net/head"
 	"github.com/dirkjabl/bricker/net/optionaldata"
 	"github.com/dirkjabl/bricker/net/payload"
@@ -57,4 +59,32 @@ func TestComputeLength(t *testing.T) {
 	}
 }
 
+func TestCopy(t *testing.T) {
+	a := NewSimpleHeaderPayload(1, 2, true, []byte("123"))
+	a.Head.SetSequence(3)
+	b := a.Copy()
+	if a.String() != b.String() {
+		t.Fatalf("Error TestCopy: copy is not equal (%s != %s)", a, b)
+	}
+	b.Head.SetSequence(4)
+	if a.Head.Sequence() != 3 || b.Head.Sequence() != 4 {
+		t.Fatalf("Error TestCopy: sequences are wrong (%d, %d)", a.Head.Sequence(), b.Head.Sequence())
+	}
+}
+
+func TestReadNewErrorCode(t *testing.T) {
+	a := NewSimpleHeaderOnly(1, 2, true)
+	a.Head.SetSequence(5)
+	a.Head.ErrorCodeAndFutureUse = errors.ErrorFUNCTIONNOTSUPPORTED << 6
+	buf := new(bytes.Buffer)
+	a.Write(buf)
+	b, err := ReadNew(buf)
+	if err == nil {
+		t.Fatal("Error TestReadNewErrorCode: no error for a packet with error code")
+	}
+	if b == nil || b.Head.Sequence() != 5 {
+		t.Fatalf("Error TestReadNewErrorCode: packet with error code should be read (%v)", b)
+	}
+}
+
 // t.Fatalf
@@ -47,9 +47,7 @@ func (p *Payload) Copy() *Payload {
 	if p == nil { // no payload, no copy
 		return nil
 	}
-	n := &Payload{}
-	copy(*p, *n)
-	return n
+	return New(*p)
 }
 
 // Write writes the payload into a given writer.
@@ -96,3 +96,15 @@ func TestWritePayload(t *testing.T) {
 		t.Fatalf("Error TestWritePayload: Get same byte slices %v != %v. ", c, b)
 	}
 }
+
+func TestCopyPayload(t *testing.T) {
+	a := New([]byte("12345"))
+	b := a.Copy()
+	if bytes.Compare(a.Bytes(), b.Bytes()) != 0 {
+		t.Fatalf("Error TestCopyPayload: copy is not equal %v != %v. ", a, b)
+	}
+	(*b)[0] = '0'
+	if (*a)[0] != '1' {
+		t.Fatalf("Error TestCopyPayload: copy changes the original (%v).", a)
+	}
+}
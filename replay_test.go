@@ -0,0 +1,169 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker_test
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector"
+	"github.com/dirkjabl/bricker/connector/buffered"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/device"
+	"github.com/dirkjabl/bricker/device/bricklet/industrialdual020ma"
+	"github.com/dirkjabl/bricker/device/bricklet/temperature"
+	"github.com/dirkjabl/bricker/device/enumerate"
+	"github.com/dirkjabl/bricker/emulator"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/net/payload"
+	"testing"
+	"time"
+)
+
+// reconnecting creates a bricker with a reconnecting connector to the emulator.
+func reconnecting(t *testing.T, emu *emulator.Emulator) (*bricker.Bricker, func()) {
+	backoff := &connector.Backoff{Initial: 10 * time.Millisecond, Max: 100 * time.Millisecond, Factor: 2.0}
+	conn, err := buffered.NewReconnecting(emu.Addr(), 10, 10, backoff)
+	if err != nil {
+		t.Fatalf("Error reconnecting: could not connect (%s).", err)
+	}
+	brick := bricker.New()
+	brick.Attach(conn, "emulator")
+	return brick, func() {
+		brick.Done()
+		conn.Done()
+	}
+}
+
+func TestReplayEnumerate(t *testing.T) {
+	emu, err := emulator.New("")
+	if err != nil {
+		t.Fatalf("Error TestReplayEnumerate: could not start emulator (%s).", err)
+	}
+	defer emu.Done()
+	emu.AddStack(emulator.Master("master"), emulator.Temperature("temp"))
+	brick, done := reconnecting(t, emu)
+	defer done()
+	enumerations := make(chan uint32, 8)
+	brick.Subscribe(enumerate.Enumerate("enumerate", false, func(r device.Resulter, err error) {
+		if en, ok := r.(*enumerate.Enumeration); ok && err == nil {
+			enumerations <- en.IntUid()
+		}
+	}), "emulator")
+	for _, state := range []string{"connect", "reconnect"} {
+		if state == "reconnect" {
+			emu.Disconnect() // the brick daemon restarts, the connector redials
+		}
+		for i := 0; i < 2; i++ { // master and temperature bricklet
+			select {
+			case <-enumerations:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Error TestReplayEnumerate: no enumeration after the %s.", state)
+			}
+		}
+	}
+}
+
+func TestReplaySetting(t *testing.T) {
+	emu, err := emulator.New("")
+	if err != nil {
+		t.Fatalf("Error TestReplaySetting: could not start emulator (%s).", err)
+	}
+	defer emu.Done()
+	temp := emulator.Temperature("temp")
+	periods := make(chan uint32, 4)
+	temp.Handle(2, func(d *emulator.Device, request *payload.Payload) interface{} { // set temperature callback period
+		p := &device.Period{}
+		if request.Decode(p) == nil {
+			periods <- p.Value
+		}
+		return nil
+	})
+	emu.AddStack(emulator.Master("master"), temp)
+	brick, done := reconnecting(t, emu)
+	defer done()
+	for _, v := range []uint32{100, 250} {
+		if err := temperature.SetTemperatureCallbackPeriodFuture(brick, "emulator", temp.Uid(), &device.Period{Value: v}); err != nil {
+			t.Fatalf("Error TestReplaySetting: could not set period (%s).", err)
+		}
+		if p := <-periods; p != v {
+			t.Fatalf("Error TestReplaySetting: wrong period %d (%d).", p, v)
+		}
+	}
+	emu.Disconnect() // the brick daemon restarts, the connector redials
+	select {
+	case p := <-periods:
+		if p != 250 {
+			t.Fatalf("Error TestReplaySetting: replayed period should be the latest (%d).", p)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestReplaySetting: setter not sent again after the reconnect.")
+	}
+	select {
+	case p := <-periods:
+		t.Fatalf("Error TestReplaySetting: period replayed twice (%d).", p)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestReplayChannels(t *testing.T) {
+	const uid = uint32(4711)
+	v := virtual.New()
+	defer v.Done()
+	requests := make(chan *packet.Packet, 8)
+	v.AttachFallbackGenerator(func(e *event.Event) *event.Event {
+		requests <- e.Packet.Copy()
+		return event.NewPacket(packet.NewSimpleHeaderOnly(uid, e.Packet.Head.FunctionID, true))
+	})
+	brick := bricker.New()
+	defer brick.Done()
+	brick.Attach(v, "virtual")
+	periods := []*industrialdual020ma.SensorPeriod{
+		{Sensor: 0, Period: device.Period{Value: 100}},
+		{Sensor: 1, Period: device.Period{Value: 200}},
+		{Sensor: 0, Period: device.Period{Value: 300}}}
+	for _, sp := range periods {
+		if err := industrialdual020ma.SetCurrentCallbackPeriodFuture(brick, "virtual", uid, sp); err != nil {
+			t.Fatalf("Error TestReplayChannels: could not set period (%s).", err)
+		}
+		<-requests
+	}
+	st := &industrialdual020ma.SensorThreshold{Sensor: 1,
+		Threshold32: device.Threshold32{Option: device.ThresholdOutside, Min: 4000000, Max: 20000000}}
+	if err := industrialdual020ma.SetCurrentCallbackThresholdFuture(brick, "virtual", uid, st); err != nil {
+		t.Fatalf("Error TestReplayChannels: could not set threshold (%s).", err)
+	}
+	<-requests
+	v.Emit(event.NewState(event.StateReconnected, nil))
+	replayed := make(map[uint8]uint32) // sensor -> period
+	thresholds := 0
+	for i := 0; i < 3; i++ {
+		select {
+		case p := <-requests:
+			if p.Head.FunctionID == 4 { // set current callback threshold
+				r := &industrialdual020ma.SensorThreshold{}
+				if p.Payload.Decode(r) != nil || *r != *st {
+					t.Fatalf("Error TestReplayChannels: wrong replayed threshold (%s).", p)
+				}
+				thresholds++
+				continue
+			}
+			sp := &industrialdual020ma.SensorPeriod{}
+			if err := p.Payload.Decode(sp); err != nil {
+				t.Fatalf("Error TestReplayChannels: wrong replayed request (%s).", p)
+			}
+			replayed[sp.Sensor] = sp.Value
+		case <-time.After(5 * time.Second):
+			t.Fatal("Error TestReplayChannels: settings not sent again after the reconnect.")
+		}
+	}
+	if thresholds != 1 || len(replayed) != 2 || replayed[0] != 300 || replayed[1] != 200 {
+		t.Fatalf("Error TestReplayChannels: wrong replayed settings (%v, %d).", replayed, thresholds)
+	}
+	select {
+	case p := <-requests:
+		t.Fatalf("Error TestReplayChannels: too many settings replayed (%s).", p)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/util/hash"
+)
+
+// Internal type: request identifies a sent packet, which waits for a response.
+// The brick daemon answers with the same uid, function id and sequence on the same connector.
+type request struct {
+	connector string
+	uid       uint32
+	fid       uint8
+	sequence  uint8
+}
+
+// Internal type: subscriberKey identifies a registered subscriber.
+type subscriberKey struct {
+	hash hash.Hash
+	id   string
+}
+
+// Internal method: keyOf creates the key of a subscriber.
+func keyOf(s Subscriber) subscriberKey {
+	return subscriberKey{hash: s.Subscription().Hash(), id: s.Id()}
+}
+
+// Internal method: requestOf creates the request for a packet sent over the named connector.
+func requestOf(connector string, p *packet.Packet) request {
+	return request{
+		connector: connector,
+		uid:       p.Head.Uid,
+		fid:       p.Head.FunctionID,
+		sequence:  p.Head.Sequence()}
+}
+
+// Internal method: waitsForResponse checks, if the subscriber waits for the response of the packet.
+// Only a packet with the same function id as the subscription expects a response for the subscriber,
+// other packets (like the enumerate request) trigger callbacks.
+func waitsForResponse(s Subscriber, p *packet.Packet) bool {
+	sub := s.Subscription()
+	return p.Head.OptionResponseExpected() &&
+		(sub.Choosen&hash.ChoosenFunctionID) == hash.ChoosenFunctionID &&
+		sub.FunctionID == p.Head.FunctionID
+}
+
+// Internal method: stamp sets the next sequence of the connector into the packet
+// and registers the subscriber for the response, if the subscriber waits for it.
+// Without a subscriber (nil), only the sequence is set.
+// The caller must hold the lock.
+func (b *Bricker) stamp(connector string, p *packet.Packet, s Subscriber) {
+	seq, ok := b.sequences[connector]
+	if !ok { // unknown connector, no sequence, the write fails later
+		return
+	}
+	p.Head.SetSequence(seq.GetSequence())
+	if s != nil && waitsForResponse(s, p) {
+		r := requestOf(connector, p)
+		b.requests[r] = append(b.requests[r], s)
+		b.waiting[keyOf(s)] = r
+	}
+}
+
+// Internal method: takeRequest removes the first subscriber, which waits for the response
+// of the request, and returns it. If no subscriber waits, the result is nil.
+// The caller must hold the lock.
+func (b *Bricker) takeRequest(r request) Subscriber {
+	subs := b.requests[r]
+	if len(subs) == 0 {
+		return nil
+	}
+	s := subs[0]
+	b.dropRequest(r, 0)
+	delete(b.waiting, keyOf(s))
+	return s
+}
+
+// Internal method: removeRequest removes the waiting request of the subscriber with the key.
+// The caller must hold the lock.
+func (b *Bricker) removeRequest(k subscriberKey) {
+	r, ok := b.waiting[k]
+	if !ok {
+		return
+	}
+	delete(b.waiting, k)
+	for i, s := range b.requests[r] {
+		if keyOf(s) == k {
+			b.dropRequest(r, i)
+			return
+		}
+	}
+}
+
+// Internal method: dropRequest removes the subscriber at the index from the waiting subscriber of the request.
+// The caller must hold the lock.
+func (b *Bricker) dropRequest(r request, i int) {
+	subs := append(b.requests[r][:i], b.requests[r][i+1:]...)
+	if len(subs) == 0 {
+		delete(b.requests, r)
+	} else {
+		b.requests[r] = subs
+	}
+}
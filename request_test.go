@@ -0,0 +1,100 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"fmt"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/subscription"
+	"github.com/dirkjabl/bricker/util/hash"
+	"testing"
+	"time"
+)
+
+type testMarker struct {
+	Value uint8
+}
+
+// getter creates a subscriber with a request, the payload of the request is the marker.
+func getter(id string, marker uint8, result chan<- string) *testSubscriber {
+	p := packet.NewSimpleHeaderPayload(1, 1, true, &testMarker{Value: marker})
+	return &testSubscriber{
+		id:  id,
+		sub: subscription.New(hash.ChoosenFunctionIDUid, 1, 1, p, false),
+		notify: func(e *event.Event) {
+			m := &testMarker{}
+			e.Packet.Payload.Decode(m)
+			result <- fmt.Sprintf("%s:%d", id, m.Value)
+		}}
+}
+
+func TestSequenceCorrelation(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	requests := make(chan *event.Event, 2)
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 1), func(e *event.Event) *event.Event {
+		requests <- e.Copy() // collect, answer later
+		return nil
+	})
+	trigger := make(chan *event.Event, 1)
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 99, 1), func(e *event.Event) *event.Event {
+		return <-trigger
+	})
+	b.Attach(v, "virtual")
+	result := make(chan string, 2)
+	b.Subscribe(getter("a", 1, result), "virtual")
+	b.Subscribe(getter("b", 2, result), "virtual")
+	first, second := <-requests, <-requests
+	// answer in the wrong order, the response echoes the request payload
+	for _, r := range []*event.Event{second, first} {
+		trigger <- r
+		v.Send(event.NewPacket(packet.NewSimpleHeaderOnly(99, 1, false)))
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case r := <-result:
+			if r != "a:1" && r != "b:2" {
+				t.Fatalf("Error TestSequenceCorrelation: subscriber got the wrong response (%s).", r)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Error TestSequenceCorrelation: no response for subscriber.")
+		}
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if len(b.requests) != 0 || len(b.waiting) != 0 {
+		t.Fatalf("Error TestSequenceCorrelation: requests left (%d, %d).", len(b.requests), len(b.waiting))
+	}
+}
+
+func TestSequenceCallback(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 8), func(e *event.Event) *event.Event {
+		return event.NewPacket(packet.NewSimpleHeaderPayload(1, 1, false, &testMarker{Value: 8}))
+	})
+	b.Attach(v, "virtual")
+	fallback := make(chan string, 1)
+	b.SubscribeDefaultFallback(&testSubscriber{id: "fallback", notify: func(e *event.Event) {
+		fallback <- "fallback"
+	}})
+	result := make(chan string, 1)
+	b.Subscribe(getter("a", 1, result), "virtual") // no response, waits
+	// the callback packet (sequence 0) does not match the waiting getter
+	v.Send(event.NewPacket(packet.NewSimpleHeaderOnly(1, 8, false)))
+	select {
+	case <-fallback:
+	case r := <-result:
+		t.Fatalf("Error TestSequenceCallback: waiting subscriber got a callback (%s).", r)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestSequenceCallback: callback not dispatched.")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/errors"
+	"github.com/dirkjabl/bricker/net/packet"
+)
+
+// Internal type: replay is the request of a callback subscription (like the enumerate request),
+// which is sent again after a connector is reconnected.
+type replay struct {
+	connector string
+	request   *packet.Packet
+}
+
+// Internal type: setting identifies a replayable request (like a callback period) of a device on a connector.
+// The channel holds the leading payload bytes, which select a channel (like the sensor) of the function.
+// Only the latest successful request of a setting is sent again after a reconnect.
+type setting struct {
+	connector string
+	uid       uint32
+	fid       uint8
+	channel   string
+}
+
+// SubscribeState register a subscriber for the state events (connected, disconnected, reconnected)
+// of all connectors. The subscription of the subscriber is not used.
+// Without a state subscriber, the state events go to the default fallback subscriber.
+func (b *Bricker) SubscribeState(s Subscriber) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.statesubscriber[s.Id()]; ok {
+		return NewError(ErrorSubscriberExists)
+	}
+	b.statesubscriber[s.Id()] = s
+	return nil
+}
+
+// UnsubscribeState release a registered state subscriber.
+func (b *Bricker) UnsubscribeState(s Subscriber) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.statesubscriber[s.Id()]; !ok {
+		return NewError(ErrorNoSubscriberToRelease)
+	}
+	delete(b.statesubscriber, s.Id())
+	return nil
+}
+
+// Internal method: dispatchState handles a state event of a connector and returns the subscriber to notify.
+// After a reconnect, the requests of the callback subscriptions and
+// the remembered settings (callback periods, thresholds, ...) of the connector are sent again.
+// After a disconnect, all subscriber, which wait for a response on the connector, get an error.
+// The caller must hold the lock.
+func (b *Bricker) dispatchState(e *event.Event) []Subscriber {
+	switch e.State {
+	case event.StateReconnected:
+		for k, r := range b.replays {
+			if r.connector == e.ConnectorName {
+				ev := event.NewPacket(r.request.Copy())
+				ev.ConnectorName = r.connector
+				b.stamp(r.connector, ev.Packet, b.subscriber[k.hash][k.id])
+				go b.write(ev)
+			}
+		}
+		for k, p := range b.settings {
+			if k.connector == e.ConnectorName {
+				ev := event.NewPacket(p.Copy())
+				ev.ConnectorName = k.connector
+				ev.Packet.Head.SetOptionResponseExpected(false) // nobody waits for the response
+				b.stamp(k.connector, ev.Packet, nil)
+				go b.write(ev)
+			}
+		}
+	case event.StateDisconnected:
+		for r, subs := range b.requests {
+			if r.connector != e.ConnectorName {
+				continue
+			}
+			for _, s := range subs {
+				delete(b.waiting, keyOf(s))
+				if !s.Subscription().Callback {
+					b.unsubscribe(s.Subscription().Hash(), s.Id())
+				}
+				ev := event.NewError(NewError(ErrorDisconnected))
+				ev.ConnectorName = e.ConnectorName
+				b.deliver(ev, s)
+			}
+			delete(b.requests, r)
+		}
+	}
+	subs := make([]Subscriber, 0, len(b.statesubscriber))
+	for _, s := range b.statesubscriber {
+		subs = append(subs, s)
+	}
+	return subs
+}
+
+// Internal method: remember stores the request of the subscriber as setting for a replay after a reconnect.
+// Only requests of subscriptions with the replay flag and a response without error are stored.
+// The caller must hold the lock.
+func (b *Bricker) remember(e *event.Event, s Subscriber) {
+	sub := s.Subscription()
+	if !sub.Replay || sub.Request == nil || e.Err != nil || e.Packet.Head.ErrorCodeNbr() != errors.ErrorOK {
+		return
+	}
+	channel := sub.Request.Payload.Bytes()
+	if len(channel) > int(sub.ReplayKey) {
+		channel = channel[:sub.ReplayKey]
+	}
+	k := setting{connector: e.ConnectorName, uid: sub.Request.Head.Uid, fid: sub.Request.Head.FunctionID, channel: string(channel)}
+	b.settings[k] = sub.Request.Copy()
+}
@@ -0,0 +1,86 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bricker
+
+import (
+	"errors"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/subscription"
+	"github.com/dirkjabl/bricker/util/hash"
+	"testing"
+	"time"
+)
+
+func TestStateSubscriber(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	b.Attach(v, "virtual")
+	states := make(chan *event.Event, 1)
+	s := &testSubscriber{id: "state", notify: func(e *event.Event) { states <- e }}
+	if err := b.SubscribeState(s); err != nil {
+		t.Fatalf("Error TestStateSubscriber: could not subscribe (%s).", err)
+	}
+	if err := b.SubscribeState(s); err == nil {
+		t.Fatal("Error TestStateSubscriber: state subscriber subscribed twice.")
+	}
+	v.Emit(event.NewState(event.StateConnected, nil))
+	select {
+	case e := <-states:
+		if e.State != event.StateConnected || e.ConnectorName != "virtual" {
+			t.Fatalf("Error TestStateSubscriber: wrong state event (%s).", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestStateSubscriber: state event not dispatched.")
+	}
+	if err := b.UnsubscribeState(s); err != nil {
+		t.Fatalf("Error TestStateSubscriber: could not unsubscribe (%s).", err)
+	}
+	if err := b.UnsubscribeState(s); err == nil {
+		t.Fatal("Error TestStateSubscriber: state subscriber unsubscribed twice.")
+	}
+}
+
+func TestStateDisconnect(t *testing.T) {
+	b := New()
+	defer b.Done()
+	v := virtual.New()
+	defer v.Done()
+	requests := make(chan *event.Event, 1)
+	v.AttachGenerator(hash.New(hash.ChoosenFunctionIDUid, 1, 1), func(e *event.Event) *event.Event {
+		requests <- e.Copy()
+		return nil // never answers
+	})
+	b.Attach(v, "virtual")
+	result := make(chan *event.Event, 1)
+	getter := &testSubscriber{
+		id:     "getter",
+		sub:    subscription.New(hash.ChoosenFunctionIDUid, 1, 1, packet.NewSimpleHeaderOnly(1, 1, true), false),
+		notify: func(e *event.Event) { result <- e }}
+	b.Subscribe(getter, "virtual")
+	select {
+	case <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestStateDisconnect: request not sent.")
+	}
+	v.Emit(event.NewState(event.StateDisconnected, errors.New("lost")))
+	select {
+	case e := <-result:
+		if err, ok := e.Err.(Error); !ok || err.Code != ErrorDisconnected {
+			t.Fatalf("Error TestStateDisconnect: waiting subscriber should get a disconnect error (%s).", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error TestStateDisconnect: waiting subscriber not released.")
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if len(b.replays) != 0 || len(b.settings) != 0 || len(b.waiting) != 0 || len(b.subscriber) != 0 {
+		t.Fatalf("Error TestStateDisconnect: state left (%d, %d, %d, %d).",
+			len(b.replays), len(b.settings), len(b.waiting), len(b.subscriber))
+	}
+}
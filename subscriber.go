@@ -7,11 +7,13 @@ package bricker
 import (
 	"github.com/dirkjabl/bricker/event"
 	"github.com/dirkjabl/bricker/subscription"
+	"github.com/dirkjabl/bricker/util/hash"
 )
 
 // Interface for all Subscriber.
 // Every Subscriber needs a subscription, which identifies the events, the subscriber wants to handle.
 // A subscriber must be bound to a bricker.
+// The bricker uses the subscriber as key for the delivery of the events, so it has to be comparable (like a pointer).
 type Subscriber interface {
 	Id() string
 	Subscription() *subscription.Subscription
@@ -21,8 +23,10 @@ type Subscriber interface {
 // Subscriber register a subscriber. Internaly it use the subscription of the subscriber.
 func (b *Bricker) Subscribe(s Subscriber, dest interface{}) error {
 	hash := s.Subscription().Hash()
+	b.lock.Lock()
 	if v, ok := b.subscriber[hash]; ok {
 		if _, ok := v[s.Id()]; ok {
+			b.lock.Unlock()
 			return NewError(ErrorSubscriberExists)
 		}
 		v[s.Id()] = s
@@ -30,9 +34,17 @@ func (b *Bricker) Subscribe(s Subscriber, dest interface{}) error {
 		b.subscriber[hash] = map[string]Subscriber{s.Id(): s}
 	}
 	b.insertChooser(s.Subscription().Choosen)
+	var ev *event.Event
 	if s.Subscription().Request != nil { // only send a event, if a packet is given
-		ev := event.NewPacket(s.Subscription().Request)
+		ev = event.NewPacket(s.Subscription().Request.Copy())
 		ev.ConnectorName = b.computeConnectorsName(dest)
+		b.stamp(ev.ConnectorName, ev.Packet, s)
+		if s.Subscription().Callback { // send the request again after a reconnect
+			b.replays[keyOf(s)] = replay{connector: ev.ConnectorName, request: s.Subscription().Request}
+		}
+	}
+	b.lock.Unlock()
+	if ev != nil {
 		go b.write(ev)
 	}
 	return nil
@@ -40,38 +52,47 @@ func (b *Bricker) Subscribe(s Subscriber, dest interface{}) error {
 
 // Unsubscribe release a registered subscriber identified with the subscription.
 func (b *Bricker) Unsubscribe(s Subscriber) error {
-	hash := s.Subscription().Hash()
-	subs, ok := b.subscriber[hash]
-	if !ok {
-		return NewError(ErrorNoSubscriberToRelease)
-	}
-	_, ok = subs[s.Id()]
-	if ok {
-		delete(subs, s.Id())
-	} else {
-		return NewError(ErrorNoSubscriberToRelease)
-	}
-	if len(subs) == 0 { // delete empty map
-		delete(b.subscriber, hash)
-	}
-	return nil
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.unsubscribe(s.Subscription().Hash(), s.Id())
 }
 
 // SubscribeDefaultFallback register a (only one) default fallback subscriber.
 // If already a default fallback subscriber is set, this subscriber would be relased.
 func (b *Bricker) SubscribeDefaultFallback(s Subscriber) {
-	if b.defaultsubscriber != nil {
-		b.UnsubscribeDefaultFallback()
-	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
 	b.defaultsubscriber = s
 }
 
 // UnsubscribeDefaultFallback relase a registered default fallback subscriber.
 func (b *Bricker) UnsubscribeDefaultFallback() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
 	b.defaultsubscriber = nil
 }
 
-// Internal method: insertChooser add a new chooser to the slice of chooser
+// Internal method: unsubscribe removes the subscriber with the id from the subscriber with the given hash.
+// The caller must hold the lock.
+func (b *Bricker) unsubscribe(h hash.Hash, id string) error {
+	subs, ok := b.subscriber[h]
+	if !ok {
+		return NewError(ErrorNoSubscriberToRelease)
+	}
+	if _, ok = subs[id]; !ok {
+		return NewError(ErrorNoSubscriberToRelease)
+	}
+	delete(subs, id)
+	b.removeRequest(subscriberKey{hash: h, id: id})
+	delete(b.replays, subscriberKey{hash: h, id: id})
+	if len(subs) == 0 { // delete empty map
+		delete(b.subscriber, h)
+	}
+	return nil
+}
+
+// Internal method: insertChooser add a new chooser to the slice of chooser.
+// The caller must hold the lock.
 func (b *Bricker) insertChooser(n uint8) {
 	for _, v := range b.choosers {
 		if v == n {
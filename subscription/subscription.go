@@ -19,12 +19,15 @@ import (
 // Identify the events a subscriber handle.
 // The flag Callback is Tinkerforge specific. Is this not a callback, then there is only one result to get.
 // The Request holds the packet, which is to send to get events.
+// With the flag Replay a successful request is remembered and sent again after a reconnect.
 type Subscription struct {
 	Choosen    uint8          // Choose which values are reconized for matching
 	Uid        uint32         // Value uid
 	FunctionID uint8          // Value Function-ID
 	Request    *packet.Packet // ip packet
 	Callback   bool           // Is this subscription a callback (get more as one result) or not (one result)
+	Replay     bool           // Should the request be sent again after a reconnect (only the latest per uid and function id)
+	ReplayKey  uint8          // Number of leading payload bytes, which select a channel (the latest request per channel is sent again)
 }
 
 // NewSubscription creates a new subscription with all informations.
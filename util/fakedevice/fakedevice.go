@@ -0,0 +1,121 @@
+// Copyright 2014 Dirk Jablonowski. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package fakedevice simulates a brick or bricklet for the tests of the device packages.
+
+The fake device answers all requests over a virtual connector, which is attached
+to a own bricker with the name Connector.
+A setter stores his payload as the response of his getter (see Store),
+all other functions respond with the payload set by Respond or without a payload.
+*/
+package fakedevice
+
+import (
+	"github.com/dirkjabl/bricker"
+	"github.com/dirkjabl/bricker/connector/virtual"
+	"github.com/dirkjabl/bricker/event"
+	"github.com/dirkjabl/bricker/net/packet"
+	"github.com/dirkjabl/bricker/net/payload"
+	"sync"
+	"testing"
+)
+
+// The name of the virtual connector in the bricker.
+const Connector = "virtual"
+
+// Device is a fake brick or bricklet with a uid.
+type Device struct {
+	lock     sync.Mutex
+	uid      uint32
+	setters  map[uint8]uint8  // setter function id -> getter function id
+	values   map[uint8][]byte // responses
+	requests map[uint8][]byte // last request payloads
+	calls    []uint8
+	brick    *bricker.Bricker
+	virtual  *virtual.Virtual
+}
+
+// New creates a fake device with the given uid and attaches it to a new bricker.
+func New(t testing.TB, uid uint32) *Device {
+	d := &Device{
+		uid:      uid,
+		setters:  make(map[uint8]uint8),
+		values:   make(map[uint8][]byte),
+		requests: make(map[uint8][]byte),
+		brick:    bricker.New(),
+		virtual:  virtual.New()}
+	d.virtual.AttachFallbackGenerator(d.generate)
+	if err := d.brick.Attach(d.virtual, Connector); err != nil {
+		d.Done()
+		t.Fatalf("Error: attach failed (%s).", err)
+	}
+	return d
+}
+
+// Bricker returns the bricker with the fake device.
+func (d *Device) Bricker() *bricker.Bricker {
+	return d.brick
+}
+
+// Done stops the bricker and the virtual connector.
+func (d *Device) Done() {
+	d.brick.Done()
+	d.virtual.Done()
+}
+
+// Store lets the setter store his payload as the response of the getter.
+func (d *Device) Store(set, get uint8) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.setters[set] = get
+}
+
+// Respond sets the response of the function id, the value will be encoded (little endian).
+func (d *Device) Respond(fid uint8, v interface{}) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.values[fid] = payload.NewPayloadEncode(v).Bytes()
+}
+
+// Request returns the payload of the last request with the function id.
+func (d *Device) Request(fid uint8) []byte {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.requests[fid]
+}
+
+// Called tests, if the function was called.
+func (d *Device) Called(fid uint8) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for _, c := range d.calls {
+		if c == fid {
+			return true
+		}
+	}
+	return false
+}
+
+// Emit sends a callback with the function id and the encoded value.
+func (d *Device) Emit(fid uint8, v interface{}) {
+	d.virtual.Emit(event.NewPacket(packet.NewSimpleHeaderPayload(d.uid, fid, false, v)))
+}
+
+// generate answers the requests like the real device.
+func (d *Device) generate(e *event.Event) *event.Event {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	fid := e.Packet.Head.FunctionID
+	d.calls = append(d.calls, fid)
+	d.requests[fid] = e.Packet.Payload.Bytes()
+	if get, ok := d.setters[fid]; ok {
+		d.values[get] = e.Packet.Payload.Bytes()
+		return event.NewPacket(packet.NewSimpleHeaderOnly(d.uid, fid, true))
+	}
+	if v, ok := d.values[fid]; ok {
+		return event.NewPacket(packet.NewSimpleHeaderPayload(d.uid, fid, true, v))
+	}
+	return event.NewPacket(packet.NewSimpleHeaderOnly(d.uid, fid, true))
+}
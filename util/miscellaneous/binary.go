@@ -19,3 +19,14 @@ func BoolToUint8(b bool) uint8 {
 func Uint8ToBool(u uint8) bool {
 	return (u & 0x01) == 0x01
 }
+
+// Converts a zero terminated (c style) string with a fixed length into a string for decoding.
+// Without a zero byte the whole byte slice is the string.
+func BytesToString(b []byte) string {
+	for i, c := range b {
+		if c == 0x00 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
@@ -44,6 +44,17 @@ func TestUint8ToBool(t *testing.T) {
 	}
 }
 
+func TestBytesToString(t *testing.T) {
+	a := [8]byte{'h', 'o', 's', 't', 0x00, 'x', 0x00, 0x00}
+	if s := BytesToString(a[:]); s != "host" {
+		t.Fatalf("Error TestBytesToString: Want the string before the zero byte (%s != host).", s)
+	}
+	b := []byte("hostname")
+	if s := BytesToString(b); s != "hostname" {
+		t.Fatalf("Error TestBytesToString: Want the whole string without zero byte (%s != hostname).", s)
+	}
+}
+
 func Test(t *testing.T) {
 	a := true
 	b := BoolToUint8(a)
@@ -32,3 +32,39 @@ func MaskToString(mask, len uint8, fillup bool) string {
 	}
 	return tm
 }
+
+// BitsToMask creates a bitmask with the given bits (0-7) set.
+// Bits greater than 7 are ignored.
+func BitsToMask(bits ...uint8) uint8 {
+	mask := uint8(0)
+	for _, b := range bits {
+		if b < 8 {
+			mask |= 1 << b
+		}
+	}
+	return mask
+}
+
+// MaskToBits gives the numbers of the set bits inside the first len bits of the bitmask.
+func MaskToBits(mask, len uint8) []uint8 {
+	bits := make([]uint8, 0, len)
+	for b := uint8(0); b < len && b < 8; b++ {
+		if mask&(1<<b) != 0 {
+			bits = append(bits, b)
+		}
+	}
+	return bits
+}
+
+// Mask16ToString converts a 16 bit bitmask to a string (see MaskToString).
+// The bitmask is filled up to 16 bit (maximum).
+func Mask16ToString(mask uint16, len uint8, fillup bool) string {
+	if len <= 8 {
+		tm := MaskToString(uint8(mask), len, false)
+		if fillup {
+			tm = strings.Repeat("0", 16-int(len)) + tm
+		}
+		return tm
+	}
+	return MaskToString(uint8(mask>>8), len-8, fillup) + MaskToString(uint8(mask), 8, false)
+}
@@ -5,6 +5,7 @@
 package miscellaneous
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -56,3 +57,58 @@ func TestMaskToString(t *testing.T) {
 		}
 	}
 }
+
+func TestBitsToMask(t *testing.T) {
+	tests := []struct {
+		bits []uint8
+		mask uint8
+	}{{bits: nil, mask: 0x00},
+		{bits: []uint8{0}, mask: 0x01},
+		{bits: []uint8{0, 2}, mask: 0x05},
+		{bits: []uint8{5, 0}, mask: 0x21},
+		{bits: []uint8{7, 7, 1}, mask: 0x82},
+		{bits: []uint8{3, 8, 200}, mask: 0x08}}
+	for _, ts := range tests {
+		if r := BitsToMask(ts.bits...); r != ts.mask {
+			t.Fatalf("Error TestBitsToMask: Wrong bitmask for %v (0x%02x != 0x%02x).", ts.bits, r, ts.mask)
+		}
+	}
+}
+
+func TestMaskToBits(t *testing.T) {
+	tests := []struct {
+		mask uint8
+		l    uint8
+		bits []uint8
+	}{{mask: 0x00, l: 8, bits: []uint8{}},
+		{mask: 0x05, l: 8, bits: []uint8{0, 2}},
+		{mask: 0xff, l: 4, bits: []uint8{0, 1, 2, 3}},
+		{mask: 0x82, l: 7, bits: []uint8{1}},
+		{mask: 0xa1, l: 7, bits: []uint8{0, 5}},
+		{mask: 0x80, l: 9, bits: []uint8{7}}}
+	for _, ts := range tests {
+		if r := MaskToBits(ts.mask, ts.l); !reflect.DeepEqual(r, ts.bits) {
+			t.Fatalf("Error TestMaskToBits: Wrong bits for 0x%02x (%v != %v).", ts.mask, r, ts.bits)
+		}
+	}
+}
+
+func TestMask16ToString(t *testing.T) {
+	tests := []struct {
+		mask uint16
+		l    uint8
+		fu   bool
+		s    string
+	}{{mask: 0x0005, l: 4, fu: false, s: "0101"},
+		{mask: 0x0005, l: 4, fu: true, s: "0000000000000101"},
+		{mask: 0xf00f, l: 8, fu: false, s: "00001111"},
+		{mask: 0x0a5f, l: 12, fu: false, s: "101001011111"},
+		{mask: 0x0a5f, l: 12, fu: true, s: "0000101001011111"},
+		{mask: 0x8001, l: 16, fu: false, s: "1000000000000001"}}
+	for _, ts := range tests {
+		if r := Mask16ToString(ts.mask, ts.l, ts.fu); r != ts.s {
+			t.Fatalf("Error TestMask16ToString: Not the right bitmask string generated (0x%04x -> %s != %s).",
+				ts.mask, ts.s, r)
+		}
+	}
+}